@@ -5,10 +5,12 @@ import (
 	"github.com/pankona/gomo-simra/simra"
 )
 
-// Background represents a sprite for background
+// Background represents a sprite for background.
+// Its texture is repeated and scrolled to the left.
 type Background struct {
 	simra.Spriter
 	speed float64
+	wrap  simra.TextureWrap
 }
 
 /**
@@ -46,11 +48,10 @@ func (bg *Background) getSpeed() float64 {
 }
 
 func (bg *Background) move() {
-	p := bg.GetPosition()
-	s := bg.GetScale()
-	p.X -= float32(bg.speed)
-	bg.SetPositionX(p.X)
-	if p.X < -1*s.W/2 {
-		bg.SetPositionX(config.ScreenWidth/2 + (config.ScreenWidth - float32(bg.speed)))
+	// offset is kept in 0..1 since the texture is repeated
+	bg.wrap.OffsetU += float32(bg.speed) / config.ScreenWidth
+	if bg.wrap.OffsetU >= 1 {
+		bg.wrap.OffsetU--
 	}
+	bg.SetTextureWrap(&bg.wrap)
 }
@@ -24,11 +24,11 @@ type modelEventListener interface {
 }
 
 type models struct {
-	ball        modeler
-	obstacles   [2]modeler
-	backgrounds [2]modeler
-	listeners   []modelEventListener
-	isDead      bool
+	ball       modeler
+	obstacles  [2]modeler
+	background modeler
+	listeners  []modelEventListener
+	isDead     bool
 }
 
 func (m *models) restart() {
@@ -47,9 +47,9 @@ func (m *models) registerObstacle(obstacle modeler, index int) {
 	m.obstacles[index] = obstacle
 }
 
-func (m *models) registerBackground(bg modeler, index int) {
+func (m *models) registerBackground(bg modeler) {
 	bg.setSpeed(3)
-	m.backgrounds[index] = bg
+	m.background = bg
 }
 
 func (m *models) addEventListener(listener modelEventListener) {
@@ -85,8 +85,7 @@ func (m *models) move() {
 	m.ball.move()
 	m.obstacles[0].move()
 	m.obstacles[1].move()
-	m.backgrounds[0].move()
-	m.backgrounds[1].move()
+	m.background.move()
 }
 
 // OnCollision is called at collision detected
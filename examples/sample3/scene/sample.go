@@ -23,7 +23,7 @@ type sample struct {
 	views         views
 	ball          Ball
 	obstacle      [2]Obstacle
-	background    [2]Background
+	background    Background
 	isTouching    bool
 	remainingLife int
 	life          [3]Life
@@ -50,6 +50,7 @@ func (s *sample) Initialize(sim simra.Simraer) {
 	s.initialize()
 	s.resetPosition()
 	s.setupSprites()
+	s.setupTexts()
 	s.registerViews()
 	s.registerModels()
 	s.remainingLife = remainingLifeAtStart
@@ -64,8 +65,7 @@ func (s *sample) Initialize(sim simra.Simraer) {
 func (s *sample) initialize() {
 	s.readytext[0] = s.simra.NewSprite()
 	s.readytext[1] = s.simra.NewSprite()
-	s.background[0].Spriter = s.simra.NewSprite()
-	s.background[1].Spriter = s.simra.NewSprite()
+	s.background.Spriter = s.simra.NewSprite()
 	s.ball.Spriter = s.simra.NewSprite()
 	s.obstacle[0].Spriter = s.simra.NewSprite()
 	s.obstacle[1].Spriter = s.simra.NewSprite()
@@ -93,22 +93,19 @@ func (s *sample) OnTouchEnd(x, y float32) {
 
 	if s.gamestate == readyToStart {
 		s.gamestate = started
-		s.removeReadyText()
+		s.hideReadyText()
 	} else if s.gamestate == readyToRestart {
 		// TODO: methodize
 		s.resetPosition()
 		s.views.restart()
 		s.models.restart()
 
-		tex := s.simra.NewImageTexture("heart.png", image.Rect(0, 0, 384, 384))
-
 		for i := 0; i < 3; i++ {
-			s.simra.AddSprite(s.life[i].Spriter)
-			s.life[i].ReplaceTexture(tex)
+			s.life[i].SetVisible(true)
 		}
 
-		s.simra.RemoveSprite(s.gameovertext[0])
-		s.simra.RemoveSprite(s.gameovertext[1])
+		s.gameovertext[0].SetVisible(false)
+		s.gameovertext[1].SetVisible(false)
 
 		s.remainingLife = remainingLifeAtStart
 
@@ -117,8 +114,7 @@ func (s *sample) OnTouchEnd(x, y float32) {
 	}
 }
 
-func (s *sample) showReadyText() {
-	// ready text. will be removed after game start
+func (s *sample) setupTexts() {
 	s.readytext[0].SetPosition(config.ScreenWidth/2, config.ScreenHeight/6*4-65/2)
 	s.readytext[0].SetScale(config.ScreenWidth, 65)
 	s.simra.AddSprite(s.readytext[0])
@@ -132,19 +128,40 @@ func (s *sample) showReadyText() {
 	tex = s.simra.NewTextTexture("TAP TO GO", 60, color.RGBA{255, 0, 0, 255}, image.Rect(0, 0, config.ScreenWidth, 65))
 	s.readytext[1].ReplaceTexture(tex)
 
+	s.gameovertext[0].SetPosition(config.ScreenWidth/2, config.ScreenHeight/6*4-65/2)
+	s.gameovertext[0].SetScale(config.ScreenWidth, 65)
+	s.simra.AddSprite(s.gameovertext[0])
+
+	s.gameovertext[1].SetPosition(config.ScreenWidth/2, config.ScreenHeight/6*3-65/2)
+	s.gameovertext[1].SetScale(config.ScreenWidth, 65)
+	s.simra.AddSprite(s.gameovertext[1])
+
+	tex = s.simra.NewTextTexture("GAME OVER", 60, color.RGBA{255, 0, 0, 255}, image.Rect(0, 0, config.ScreenWidth, 65))
+	s.gameovertext[0].ReplaceTexture(tex)
+	tex = s.simra.NewTextTexture("RESTART!!", 60, color.RGBA{255, 0, 0, 255}, image.Rect(0, 0, config.ScreenWidth, 65))
+	s.gameovertext[1].ReplaceTexture(tex)
+
+	// texts are shown on demand
+	s.readytext[0].SetVisible(false)
+	s.readytext[1].SetVisible(false)
+	s.gameovertext[0].SetVisible(false)
+	s.gameovertext[1].SetVisible(false)
+}
+
+func (s *sample) showReadyText() {
+	// ready text. will be hidden after game start
+	s.readytext[0].SetVisible(true)
+	s.readytext[1].SetVisible(true)
 }
 
-func (s *sample) removeReadyText() {
-	s.simra.RemoveSprite(s.readytext[0])
-	s.simra.RemoveSprite(s.readytext[1])
+func (s *sample) hideReadyText() {
+	s.readytext[0].SetVisible(false)
+	s.readytext[1].SetVisible(false)
 }
 
 func (s *sample) resetPosition() {
-	s.background[0].SetScale(config.ScreenWidth+1, config.ScreenHeight)
-	s.background[0].SetPosition(config.ScreenWidth/2, config.ScreenHeight/2)
-
-	s.background[1].SetScale(config.ScreenWidth+1, config.ScreenHeight)
-	s.background[1].SetPosition(config.ScreenWidth/2+(config.ScreenWidth), config.ScreenHeight/2)
+	s.background.SetScale(config.ScreenWidth, config.ScreenHeight)
+	s.background.SetPosition(config.ScreenWidth/2, config.ScreenHeight/2)
 
 	s.ball.SetScale(48, 48)
 	s.ball.SetPosition(config.ScreenWidth/2, config.ScreenHeight/2)
@@ -163,8 +180,7 @@ func (s *sample) resetPosition() {
 }
 
 func (s *sample) setupSprites() {
-	s.simra.AddSprite(s.background[0].Spriter)
-	s.simra.AddSprite(s.background[1].Spriter)
+	s.simra.AddSprite(s.background.Spriter)
 	s.simra.AddSprite(s.ball.Spriter)
 	s.simra.AddSprite(s.obstacle[0].Spriter)
 	s.simra.AddSprite(s.obstacle[1].Spriter)
@@ -172,11 +188,10 @@ func (s *sample) setupSprites() {
 	s.simra.AddSprite(s.life[1].Spriter)
 	s.simra.AddSprite(s.life[2].Spriter)
 
+	// background texture is repeated and scrolled by Background
 	tex := s.simra.NewImageTexture("bg.png", image.Rect(0, 0, config.ScreenWidth, config.ScreenHeight))
-	s.background[0].ReplaceTexture(tex)
-
-	tex = s.simra.NewImageTexture("bg.png", image.Rect(0, 0, config.ScreenWidth, config.ScreenHeight))
-	s.background[1].ReplaceTexture(tex)
+	s.background.ReplaceTexture(tex)
+	s.background.SetTextureWrap(&s.background.wrap)
 
 	scale := s.ball.GetScale()
 	tex = s.simra.NewImageTexture("ball.png", image.Rect(0, 0, scale.W, scale.H))
@@ -198,18 +213,8 @@ func (s *sample) registerViews() {
 }
 
 func (s *sample) showGameover() {
-	s.gameovertext[0].SetPosition(config.ScreenWidth/2, config.ScreenHeight/6*4-65/2)
-	s.gameovertext[0].SetScale(config.ScreenWidth, 65)
-	s.simra.AddSprite(s.gameovertext[0])
-
-	s.gameovertext[1].SetPosition(config.ScreenWidth/2, config.ScreenHeight/6*3-65/2)
-	s.gameovertext[1].SetScale(config.ScreenWidth, 65)
-	s.simra.AddSprite(s.gameovertext[1])
-
-	tex := s.simra.NewTextTexture("GAME OVER", 60, color.RGBA{255, 0, 0, 255}, image.Rect(0, 0, config.ScreenWidth, 65))
-	s.gameovertext[0].ReplaceTexture(tex)
-	tex = s.simra.NewTextTexture("RESTART!!", 60, color.RGBA{255, 0, 0, 255}, image.Rect(0, 0, config.ScreenWidth, 65))
-	s.gameovertext[1].ReplaceTexture(tex)
+	s.gameovertext[0].SetVisible(true)
+	s.gameovertext[1].SetVisible(true)
 }
 
 func (s *sample) onFinishDead() {
@@ -224,7 +229,7 @@ func (s *sample) onFinishDead() {
 	s.views.restart()
 	s.models.restart()
 
-	s.simra.RemoveSprite(s.life[s.remainingLife-1].Spriter)
+	s.life[s.remainingLife-1].SetVisible(false)
 	s.remainingLife--
 }
 
@@ -232,8 +237,7 @@ func (s *sample) registerModels() {
 	s.models.registerBall(&s.ball)
 	s.models.registerObstacle(&s.obstacle[0], 0)
 	s.models.registerObstacle(&s.obstacle[1], 1)
-	s.models.registerBackground(&s.background[0], 0)
-	s.models.registerBackground(&s.background[1], 1)
+	s.models.registerBackground(&s.background)
 	s.models.addEventListener(&s.views)
 }
 
@@ -11,4 +11,6 @@ require (
 	golang.org/x/sys v0.0.0-20190712062909-fae7ac547cb7 // indirect
 )
 
+replace golang.org/x/mobile => ./third_party/mobile
+
 go 1.13
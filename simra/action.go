@@ -0,0 +1,632 @@
+package simra
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/pankona/gomo-simra/simra/simlog"
+	"golang.org/x/mobile/event/key"
+)
+
+// Bindings represents a set of input bindings for named actions and axes.
+// It can be serialized as JSON like below.
+//
+//	{
+//	  "actions": {
+//	    "Jump": [{"key": "Spacebar"}, {"sprite": "jumpButton"}],
+//	    "Pause": [{"touch": {"x": 500, "y": 920, "w": 80, "h": 80}}]
+//	  },
+//	  "axes": {
+//	    "MoveX": [
+//	      {"negative": {"key": "LeftArrow"}, "positive": {"key": "RightArrow"}},
+//	      {"stick": "leftStick", "axis": "x"}
+//	    ]
+//	  }
+//	}
+type Bindings struct {
+	Actions map[string][]Binding     `json:"actions,omitempty"`
+	Axes    map[string][]AxisBinding `json:"axes,omitempty"`
+}
+
+// Binding represents an input source bound to an action.
+// Only one of Key, Touch and Sprite should be specified.
+type Binding struct {
+	// Key is a name of key, like "A", "Spacebar" or "LeftArrow".
+	// Names are the ones of golang.org/x/mobile/event/key.Code without "Code" prefix.
+	Key string `json:"key,omitempty"`
+	// Touch is a region on virtual screen.
+	// The binding is active while the region is touched.
+	Touch *Region `json:"touch,omitempty"`
+	// Sprite is a name of sprite registered by ActionMap.RegisterSprite.
+	// The binding is active while the sprite is touched.
+	Sprite string `json:"sprite,omitempty"`
+}
+
+// AxisBinding represents an input source bound to an axis.
+// Either pair of Negative and Positive, or Stick should be specified.
+type AxisBinding struct {
+	// Negative is a binding that makes axis value -1 while it is active.
+	Negative *Binding `json:"negative,omitempty"`
+	// Positive is a binding that makes axis value +1 while it is active.
+	Positive *Binding `json:"positive,omitempty"`
+	// Stick is a name of VirtualStick registered by ActionMap.RegisterStick.
+	Stick string `json:"stick,omitempty"`
+	// Axis specifies which axis of Stick is used. "x" or "y".
+	Axis string `json:"axis,omitempty"`
+}
+
+// Region represents a rectangle on virtual screen.
+// X and Y are the center of the rectangle as same as sprite's position.
+type Region struct {
+	X float32 `json:"x"`
+	Y float32 `json:"y"`
+	W float32 `json:"w"`
+	H float32 `json:"h"`
+}
+
+func (r *Region) contains(x, y float32) bool {
+	return x >= r.X-r.W/2 && x <= r.X+r.W/2 &&
+		y >= r.Y-r.H/2 && y <= r.Y+r.H/2
+}
+
+var (
+	keyCodesOnce sync.Once
+	keyCodes     map[string]key.Code
+)
+
+// keyCodeByName returns key.Code for specified name like "Spacebar".
+func keyCodeByName(name string) (key.Code, bool) {
+	keyCodesOnce.Do(func() {
+		keyCodes = map[string]key.Code{}
+		codes := []key.Code{key.CodeCompose}
+		for c := key.Code(0); c < 256; c++ {
+			codes = append(codes, c)
+		}
+		for _, c := range codes {
+			n := c.String()
+			if strings.HasPrefix(n, "Code(") {
+				// not a named key
+				continue
+			}
+			keyCodes[strings.TrimPrefix(n, "Code")] = c
+		}
+	})
+	c, ok := keyCodes[name]
+	return c, ok
+}
+
+// ErrBindingsNotFound is returned by ActionMap.Load if no bindings are stored
+// with specified key.
+var ErrBindingsNotFound = errors.New("bindings not found")
+
+// checkNames returns an error if specified JSON object has an empty or
+// duplicate name. json.Unmarshal silently keeps the last one of duplicates.
+func checkNames(kind string, obj json.RawMessage) error {
+	if len(obj) == 0 || bytes.Equal(obj, []byte("null")) {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(obj))
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	names := map[string]bool{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		name, _ := tok.(string)
+		if name == "" {
+			return fmt.Errorf("empty %s name", kind)
+		}
+		if names[name] {
+			return fmt.Errorf("duplicate %s name: %s", kind, name)
+		}
+		names[name] = true
+		var v json.RawMessage
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseBindings parses JSON representation of Bindings.
+// Unknown key names, and empty or duplicate names of actions and axes are
+// reported as an error.
+func ParseBindings(b []byte) (*Bindings, error) {
+	bindings := &Bindings{}
+	err := json.Unmarshal(b, bindings)
+	if err != nil {
+		return nil, err
+	}
+	var objs struct {
+		Actions json.RawMessage `json:"actions"`
+		Axes    json.RawMessage `json:"axes"`
+	}
+	if err := json.Unmarshal(b, &objs); err != nil {
+		return nil, err
+	}
+	if err := checkNames("action", objs.Actions); err != nil {
+		return nil, err
+	}
+	if err := checkNames("axis", objs.Axes); err != nil {
+		return nil, err
+	}
+
+	validate := func(b *Binding) error {
+		if b == nil || b.Key == "" {
+			return nil
+		}
+		if _, ok := keyCodeByName(b.Key); !ok {
+			return fmt.Errorf("unknown key name: %s", b.Key)
+		}
+		return nil
+	}
+	for _, bs := range bindings.Actions {
+		for i := range bs {
+			if err := validate(&bs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, bs := range bindings.Axes {
+		for _, b := range bs {
+			if err := validate(b.Negative); err != nil {
+				return nil, err
+			}
+			if err := validate(b.Positive); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return bindings, nil
+}
+
+// VirtualStick represents an on-screen analog stick.
+// Touching inside of base sprite and dragging produces
+// axis values in range of [-1, 1] for both x and y.
+type VirtualStick struct {
+	base   Spriter
+	active bool
+	// id is the touch that moves the stick, while active
+	id   int
+	x, y float32
+}
+
+// NewVirtualStick returns an instance of VirtualStick.
+// Size and position of base sprite determine the movable range of the stick.
+func NewVirtualStick(base Spriter) *VirtualStick {
+	return &VirtualStick{base: base}
+}
+
+// X returns current value of x axis of the stick
+func (vs *VirtualStick) X() float32 {
+	return vs.x
+}
+
+// Y returns current value of y axis of the stick
+func (vs *VirtualStick) Y() float32 {
+	return vs.y
+}
+
+func (vs *VirtualStick) update(x, y float32) {
+	p := vs.base.GetPosition()
+	s := vs.base.GetScale()
+	if s.W == 0 || s.H == 0 {
+		return
+	}
+	dx := (x - p.X) / (s.W / 2)
+	dy := (y - p.Y) / (s.H / 2)
+	l := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if l > 1 {
+		dx, dy = dx/l, dy/l
+	}
+	vs.x, vs.y = dx, dy
+}
+
+func (vs *VirtualStick) onTouchBegin(id int, x, y float32) {
+	if vs.active {
+		return
+	}
+	sp, ok := spriteOf(vs.base)
+	if !ok || !sp.Contains(x, y) {
+		return
+	}
+	vs.active, vs.id = true, id
+	vs.update(x, y)
+}
+
+func (vs *VirtualStick) onTouchMove(id int, x, y float32) {
+	if vs.active && vs.id == id {
+		vs.update(x, y)
+	}
+}
+
+func (vs *VirtualStick) onTouchEnd(id int, x, y float32) {
+	if vs.active && vs.id == id {
+		vs.active = false
+		vs.x, vs.y = 0, 0
+	}
+}
+
+// ActionMap maps named actions and axes to inputs.
+// Gameplay code can query state of actions and axes by name
+// instead of handling touches and keys separately.
+//
+// State of ActionMap is updated once per frame before Driver.Drive is called,
+// so the result of IsPressed and IsReleased are consistent within a frame.
+type ActionMap struct {
+	mu       sync.Mutex
+	bindings *Bindings
+	sprites  map[string]Spriter
+	sticks   map[string]*VirtualStick
+
+	// raw input state updated by events
+	keys        map[key.Code]bool
+	keysLatched map[key.Code]bool
+	// touches are positions of touches in progress by id
+	touches map[int]touchPoint
+	// touchesLatched are last positions of touches began since last update
+	touchesLatched map[int]touchPoint
+
+	// per-frame state updated by update
+	held     map[string]bool
+	prevHeld map[string]bool
+	axes     map[string]float32
+}
+
+// NewActionMap returns an instance of ActionMap that has no bindings.
+// Note that ActionMap needs to be registered to simra by Simraer.AddActionMap
+// to receive input events.
+func NewActionMap() *ActionMap {
+	return &ActionMap{
+		bindings:       &Bindings{},
+		sprites:        map[string]Spriter{},
+		sticks:         map[string]*VirtualStick{},
+		keys:           map[key.Code]bool{},
+		keysLatched:    map[key.Code]bool{},
+		touches:        map[int]touchPoint{},
+		touchesLatched: map[int]touchPoint{},
+		held:           map[string]bool{},
+		prevHeld:       map[string]bool{},
+		axes:           map[string]float32{},
+	}
+}
+
+// touchPoint is a touched position on virtual screen
+type touchPoint struct {
+	x, y float32
+}
+
+// SetBindings replaces all bindings with specified ones.
+func (am *ActionMap) SetBindings(b *Bindings) {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	am.bindings = b
+}
+
+// GetBindings returns current bindings.
+func (am *ActionMap) GetBindings() *Bindings {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	return am.bindings
+}
+
+// BindAction adds a binding to specified action.
+func (am *ActionMap) BindAction(action string, b Binding) {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	if am.bindings.Actions == nil {
+		am.bindings.Actions = map[string][]Binding{}
+	}
+	am.bindings.Actions[action] = append(am.bindings.Actions[action], b)
+}
+
+// BindAxis adds a binding to specified axis.
+func (am *ActionMap) BindAxis(axis string, b AxisBinding) {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	if am.bindings.Axes == nil {
+		am.bindings.Axes = map[string][]AxisBinding{}
+	}
+	am.bindings.Axes[axis] = append(am.bindings.Axes[axis], b)
+}
+
+// RegisterSprite registers a sprite with a name.
+// The name can be used for Binding.Sprite.
+func (am *ActionMap) RegisterSprite(name string, s Spriter) {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	am.sprites[name] = s
+}
+
+// RegisterStick registers a VirtualStick with a name.
+// The name can be used for AxisBinding.Stick.
+func (am *ActionMap) RegisterStick(name string, vs *VirtualStick) {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	am.sticks[name] = vs
+}
+
+// LoadJSON replaces bindings with the ones represented by specified JSON.
+// Bindings are not replaced if they are invalid.
+func (am *ActionMap) LoadJSON(b []byte) error {
+	bindings, err := ParseBindings(b)
+	if err != nil {
+		return err
+	}
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	if err := am.checkSticks(bindings); err != nil {
+		return err
+	}
+	am.bindings = bindings
+	return nil
+}
+
+// checkSticks returns an error if a registered stick bound to an axis
+// doesn't have a sprite of simra as its base.
+func (am *ActionMap) checkSticks(b *Bindings) error {
+	for axis, bs := range b.Axes {
+		for _, ab := range bs {
+			vs, ok := am.sticks[ab.Stick]
+			if ab.Stick == "" || !ok {
+				continue
+			}
+			if _, ok := spriteOf(vs.base); !ok {
+				return fmt.Errorf("base of stick %s bound to %s is not a sprite: %T", ab.Stick, axis, vs.base)
+			}
+		}
+	}
+	return nil
+}
+
+// MarshalJSON returns JSON representation of current bindings.
+func (am *ActionMap) MarshalJSON() ([]byte, error) {
+	return json.Marshal(am.GetBindings())
+}
+
+// Save stores current bindings to database with specified key.
+// This is useful to keep bindings remapped by user.
+func (am *ActionMap) Save(db *Database, key string) error {
+	b, err := am.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	db.Put(key, string(b))
+	return nil
+}
+
+// Load replaces bindings with the ones stored in database with specified key.
+// ErrBindingsNotFound is returned if nothing is stored with the key.
+func (am *ActionMap) Load(db *Database, key string) error {
+	var b []byte
+	switch v := db.Get(key).(type) {
+	case string:
+		b = []byte(v)
+	case []byte:
+		b = v
+	case nil:
+		return ErrBindingsNotFound
+	default:
+		return fmt.Errorf("unexpected type of stored bindings: %T", v)
+	}
+	return am.LoadJSON(b)
+}
+
+// IsPressed returns true if specified action becomes active at this frame.
+func (am *ActionMap) IsPressed(action string) bool {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	return am.held[action] && !am.prevHeld[action]
+}
+
+// IsHeld returns true while specified action is active.
+func (am *ActionMap) IsHeld(action string) bool {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	return am.held[action]
+}
+
+// IsReleased returns true if specified action becomes inactive at this frame.
+func (am *ActionMap) IsReleased(action string) bool {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	return !am.held[action] && am.prevHeld[action]
+}
+
+// Axis returns current value of specified axis in range of [-1, 1].
+// If multiple bindings are active, their values are summed up and clamped.
+func (am *ActionMap) Axis(axis string) float32 {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	return am.axes[axis]
+}
+
+func (am *ActionMap) isActive(b *Binding) bool {
+	if b == nil {
+		return false
+	}
+	if b.Key != "" {
+		if c, ok := keyCodeByName(b.Key); ok && (am.keys[c] || am.keysLatched[c]) {
+			return true
+		}
+	}
+	if b.Touch == nil && b.Sprite == "" {
+		return false
+	}
+	for _, p := range am.touches {
+		if am.isTouched(b, p) {
+			return true
+		}
+	}
+	for _, p := range am.touchesLatched {
+		if am.isTouched(b, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// isTouched returns true if touch binding b contains the touched point
+func (am *ActionMap) isTouched(b *Binding, p touchPoint) bool {
+	if b.Touch != nil && b.Touch.contains(p.x, p.y) {
+		return true
+	}
+	if b.Sprite != "" {
+		if s, ok := spriteOf(am.sprites[b.Sprite]); ok && s.Contains(p.x, p.y) {
+			return true
+		}
+	}
+	return false
+}
+
+// update calculates state of actions and axes for current frame.
+func (am *ActionMap) update() {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+
+	am.prevHeld, am.held = am.held, am.prevHeld
+	for k := range am.held {
+		delete(am.held, k)
+	}
+	for action, bs := range am.bindings.Actions {
+		for i := range bs {
+			if am.isActive(&bs[i]) {
+				am.held[action] = true
+				break
+			}
+		}
+	}
+
+	for k := range am.axes {
+		delete(am.axes, k)
+	}
+	for axis, bs := range am.bindings.Axes {
+		var v float32
+		for i := range bs {
+			b := &bs[i]
+			if b.Stick != "" {
+				if vs, ok := am.sticks[b.Stick]; ok {
+					if b.Axis == "y" {
+						v += vs.Y()
+					} else {
+						v += vs.X()
+					}
+				}
+				continue
+			}
+			if am.isActive(b.Negative) {
+				v--
+			}
+			if am.isActive(b.Positive) {
+				v++
+			}
+		}
+		if v > 1 {
+			v = 1
+		} else if v < -1 {
+			v = -1
+		}
+		am.axes[axis] = v
+	}
+
+	for k := range am.keysLatched {
+		delete(am.keysLatched, k)
+	}
+	for k := range am.touchesLatched {
+		delete(am.touchesLatched, k)
+	}
+}
+
+// OnKeyDown is called when a key is pressed.
+func (am *ActionMap) OnKeyDown(code key.Code) {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	am.keys[code] = true
+	// latch the key to avoid to miss a press
+	// that is released before next frame
+	am.keysLatched[code] = true
+}
+
+// OnKeyUp is called when a key is released.
+func (am *ActionMap) OnKeyUp(code key.Code) {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	am.keys[code] = false
+}
+
+// OnTouchBegin is called when screen is touched.
+// It is regarded as the touch of id 0.
+func (am *ActionMap) OnTouchBegin(x, y float32) {
+	am.OnMultiTouchBegin(0, x, y)
+}
+
+// OnTouchMove is called when touch is moved.
+// It is regarded as the touch of id 0.
+func (am *ActionMap) OnTouchMove(x, y float32) {
+	am.OnMultiTouchMove(0, x, y)
+}
+
+// OnTouchEnd is called when touch is released.
+// It is regarded as the touch of id 0.
+func (am *ActionMap) OnTouchEnd(x, y float32) {
+	am.OnMultiTouchEnd(0, x, y)
+}
+
+// OnMultiTouchBegin is called when screen is touched.
+// Touches of different ids are tracked separately.
+func (am *ActionMap) OnMultiTouchBegin(id int, x, y float32) {
+	simlog.FuncIn()
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	p := touchPoint{x, y}
+	am.touches[id] = p
+	// latch the touch to avoid to miss a tap
+	// that is released before next frame
+	am.touchesLatched[id] = p
+	for _, vs := range am.sticks {
+		vs.onTouchBegin(id, x, y)
+	}
+	simlog.FuncOut()
+}
+
+// OnMultiTouchMove is called when touch is moved.
+func (am *ActionMap) OnMultiTouchMove(id int, x, y float32) {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	am.moveTouch(id, x, y)
+	for _, vs := range am.sticks {
+		vs.onTouchMove(id, x, y)
+	}
+}
+
+// OnMultiTouchEnd is called when touch is released.
+func (am *ActionMap) OnMultiTouchEnd(id int, x, y float32) {
+	simlog.FuncIn()
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	am.moveTouch(id, x, y)
+	delete(am.touches, id)
+	for _, vs := range am.sticks {
+		vs.onTouchEnd(id, x, y)
+	}
+	simlog.FuncOut()
+}
+
+// moveTouch updates position of the touch of id
+func (am *ActionMap) moveTouch(id int, x, y float32) {
+	p := touchPoint{x, y}
+	if _, ok := am.touches[id]; ok {
+		am.touches[id] = p
+	}
+	if _, ok := am.touchesLatched[id]; ok {
+		am.touchesLatched[id] = p
+	}
+}
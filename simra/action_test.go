@@ -0,0 +1,257 @@
+package simra
+
+import (
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/mobile/event/key"
+)
+
+func TestActionMapKey(t *testing.T) {
+	am := NewActionMap()
+	am.BindAction("Jump", Binding{Key: "Spacebar"})
+
+	tcs := []struct {
+		event    func()
+		pressed  bool
+		held     bool
+		released bool
+	}{
+		{event: func() {}, pressed: false, held: false, released: false},
+		{event: func() { am.OnKeyDown(key.CodeSpacebar) }, pressed: true, held: true, released: false},
+		{event: func() {}, pressed: false, held: true, released: false},
+		{event: func() { am.OnKeyUp(key.CodeSpacebar) }, pressed: false, held: false, released: true},
+		{event: func() {}, pressed: false, held: false, released: false},
+		// press and release between frames is not missed
+		{event: func() {
+			am.OnKeyDown(key.CodeSpacebar)
+			am.OnKeyUp(key.CodeSpacebar)
+		}, pressed: true, held: true, released: false},
+		{event: func() {}, pressed: false, held: false, released: true},
+	}
+	for i, tc := range tcs {
+		tc.event()
+		am.update()
+		if am.IsPressed("Jump") != tc.pressed ||
+			am.IsHeld("Jump") != tc.held ||
+			am.IsReleased("Jump") != tc.released {
+			t.Errorf("[%d] unexpected result. [got] pressed=%t held=%t released=%t [want] pressed=%t held=%t released=%t",
+				i, am.IsPressed("Jump"), am.IsHeld("Jump"), am.IsReleased("Jump"),
+				tc.pressed, tc.held, tc.released)
+		}
+	}
+}
+
+func TestActionMapTouch(t *testing.T) {
+	am := NewActionMap()
+	am.BindAction("Pause", Binding{Touch: &Region{X: 100, Y: 100, W: 20, H: 20}})
+
+	s := &sprite{}
+	s.SetPosition(300, 300)
+	s.SetScale(50, 50)
+	am.RegisterSprite("fire", s)
+	am.BindAction("Fire", Binding{Sprite: "fire"})
+
+	am.OnTouchBegin(105, 95)
+	am.update()
+	if !am.IsPressed("Pause") || am.IsHeld("Fire") {
+		t.Errorf("unexpected result. [got] pause=%t fire=%t", am.IsPressed("Pause"), am.IsHeld("Fire"))
+	}
+
+	am.OnTouchMove(310, 290)
+	am.update()
+	if !am.IsReleased("Pause") || !am.IsPressed("Fire") {
+		t.Errorf("unexpected result. [got] pause released=%t fire pressed=%t",
+			am.IsReleased("Pause"), am.IsPressed("Fire"))
+	}
+
+	am.OnTouchEnd(310, 290)
+	am.update()
+	if !am.IsReleased("Fire") {
+		t.Errorf("unexpected result. fire should be released")
+	}
+}
+
+func TestActionMapMultiTouch(t *testing.T) {
+	am := NewActionMap()
+	am.BindAction("Jump", Binding{Touch: &Region{X: 400, Y: 100, W: 50, H: 50}})
+	base := &sprite{}
+	base.SetPosition(100, 100)
+	base.SetScale(100, 100)
+	am.RegisterStick("stick", NewVirtualStick(base))
+	am.BindAxis("MoveX", AxisBinding{Stick: "stick", Axis: "x"})
+
+	// hold the stick and tap the button
+	am.OnMultiTouchBegin(0, 125, 100)
+	am.OnMultiTouchBegin(1, 410, 110)
+	am.update()
+	if v := am.Axis("MoveX"); v != 0.5 || !am.IsPressed("Jump") {
+		t.Errorf("unexpected result. [got] x=%f jump=%t", v, am.IsPressed("Jump"))
+	}
+
+	// touch on the button doesn't move the stick
+	am.OnMultiTouchMove(1, 420, 110)
+	am.update()
+	if v := am.Axis("MoveX"); v != 0.5 || !am.IsHeld("Jump") {
+		t.Errorf("unexpected result. [got] x=%f jump=%t", v, am.IsHeld("Jump"))
+	}
+
+	// releasing the button keeps the stick
+	am.OnMultiTouchEnd(1, 420, 110)
+	am.update()
+	if v := am.Axis("MoveX"); v != 0.5 || !am.IsReleased("Jump") {
+		t.Errorf("unexpected result. [got] x=%f jump released=%t", v, am.IsReleased("Jump"))
+	}
+
+	// releasing the stick keeps the button
+	am.OnMultiTouchBegin(1, 410, 110)
+	am.OnMultiTouchEnd(0, 125, 100)
+	am.update()
+	if v := am.Axis("MoveX"); v != 0 || !am.IsHeld("Jump") {
+		t.Errorf("unexpected result. [got] x=%f jump=%t", v, am.IsHeld("Jump"))
+	}
+
+	// a tap that ends before next frame is not missed
+	am.OnMultiTouchEnd(1, 410, 110)
+	am.update()
+	am.OnMultiTouchBegin(2, 410, 110)
+	am.OnMultiTouchEnd(2, 410, 110)
+	am.update()
+	if !am.IsPressed("Jump") {
+		t.Errorf("unexpected result. jump should be pressed")
+	}
+}
+
+func TestActionMapAxis(t *testing.T) {
+	am := NewActionMap()
+	am.BindAxis("MoveX", AxisBinding{
+		Negative: &Binding{Key: "LeftArrow"},
+		Positive: &Binding{Key: "RightArrow"},
+	})
+	base := &sprite{}
+	base.SetPosition(100, 100)
+	base.SetScale(100, 100)
+	am.RegisterStick("stick", NewVirtualStick(base))
+	am.BindAxis("MoveX", AxisBinding{Stick: "stick", Axis: "x"})
+	am.BindAxis("MoveY", AxisBinding{Stick: "stick", Axis: "y"})
+
+	am.OnKeyDown(key.CodeLeftArrow)
+	am.update()
+	if v := am.Axis("MoveX"); v != -1 {
+		t.Errorf("unexpected result. [got] %f [want] %f", v, -1.0)
+	}
+
+	am.OnKeyDown(key.CodeRightArrow)
+	am.update()
+	if v := am.Axis("MoveX"); v != 0 {
+		t.Errorf("unexpected result. [got] %f [want] %f", v, 0.0)
+	}
+
+	am.OnKeyUp(key.CodeLeftArrow)
+	am.OnKeyUp(key.CodeRightArrow)
+	am.OnTouchBegin(125, 100)
+	am.update()
+	if v := am.Axis("MoveX"); v != 0.5 {
+		t.Errorf("unexpected result. [got] %f [want] %f", v, 0.5)
+	}
+
+	// value of stick is clamped by unit circle
+	am.OnTouchMove(100, 300)
+	am.update()
+	if x, y := am.Axis("MoveX"), am.Axis("MoveY"); x != 0 || y != 1 {
+		t.Errorf("unexpected result. [got] (%f, %f) [want] (%f, %f)", x, y, 0.0, 1.0)
+	}
+
+	am.OnTouchEnd(100, 300)
+	am.update()
+	if v := am.Axis("MoveY"); v != 0 {
+		t.Errorf("unexpected result. [got] %f [want] %f", v, 0.0)
+	}
+}
+
+func TestActionMapJSON(t *testing.T) {
+	am := NewActionMap()
+	err := am.LoadJSON([]byte(`{
+		"actions": {"Jump": [{"key": "Spacebar"}, {"key": "W"}]},
+		"axes": {"MoveX": [{"negative": {"key": "A"}, "positive": {"key": "D"}}]}
+	}`))
+	if err != nil {
+		t.Fatalf("failed to load json. err: %s", err.Error())
+	}
+
+	am.OnKeyDown(key.CodeW)
+	am.OnKeyDown(key.CodeD)
+	am.update()
+	if !am.IsPressed("Jump") || am.Axis("MoveX") != 1 {
+		t.Errorf("unexpected result. [got] jump=%t movex=%f", am.IsPressed("Jump"), am.Axis("MoveX"))
+	}
+
+	err = am.LoadJSON([]byte(`{"actions": {"Jump": [{"key": "NoSuchKey"}]}}`))
+	if err == nil {
+		t.Errorf("unknown key name should be an error")
+	}
+}
+
+func TestActionMapDatabase(t *testing.T) {
+	db, err := OpenDB(&mock{}, filepath.Join("."))
+	if err != nil {
+		t.Fatalf("failed to open database. err: %s", err.Error())
+	}
+	defer db.Close()
+
+	am := NewActionMap()
+	am.BindAction("Jump", Binding{Key: "Spacebar"})
+	err = am.Save(db, "bindings")
+	if err != nil {
+		t.Fatalf("failed to save bindings. err: %s", err.Error())
+	}
+
+	loaded := NewActionMap()
+	err = loaded.Load(db, "bindings")
+	if err != nil {
+		t.Fatalf("failed to load bindings. err: %s", err.Error())
+	}
+	bs := loaded.GetBindings().Actions["Jump"]
+	if len(bs) != 1 || bs[0].Key != "Spacebar" {
+		t.Errorf("unexpected result. [got] %v", bs)
+	}
+
+	err = loaded.Load(db, "not-exist")
+	if err != ErrBindingsNotFound {
+		t.Errorf("unexpected result. [got] %v [want] %v", err, ErrBindingsNotFound)
+	}
+}
+
+// notSprite is a Spriter that is not a sprite of simra
+type notSprite struct {
+	Spriter
+}
+
+func TestActionMapInvalidBindings(t *testing.T) {
+	am := NewActionMap()
+	am.RegisterStick("stick", NewVirtualStick(&notSprite{}))
+	am.BindAction("Jump", Binding{Key: "Spacebar"})
+
+	tcs := []string{
+		`{"actions": {"": [{"key": "A"}]}}`,
+		`{"actions": {"Jump": [{"key": "A"}], "Jump": [{"key": "B"}]}}`,
+		`{"axes": {"MoveX": [], "MoveX": []}}`,
+		`{"axes": {"MoveX": [{"stick": "stick", "axis": "x"}]}}`,
+	}
+	for i, tc := range tcs {
+		if err := am.LoadJSON([]byte(tc)); err == nil {
+			t.Errorf("[%d] invalid bindings should be an error", i)
+		}
+	}
+	// bindings are kept on error
+	bs := am.GetBindings().Actions["Jump"]
+	if len(bs) != 1 || bs[0].Key != "Spacebar" {
+		t.Errorf("unexpected result. [got] %v", bs)
+	}
+
+	// same names for an action and an axis are allowed
+	err := am.LoadJSON([]byte(`{"actions": {"Move": []}, "axes": {"Move": []}}`))
+	if err != nil {
+		t.Errorf("unexpected error. err: %s", err.Error())
+	}
+}
@@ -0,0 +1,67 @@
+package simra
+
+import (
+	"github.com/pankona/gomo-simra/simra/internal/peer"
+	"github.com/pankona/gomo-simra/simra/simlog"
+)
+
+// Atlas represents textures of frames packed into sheets by TexturePacker.
+type Atlas struct {
+	simra    *simra
+	atlas    *peer.Atlas
+	textures map[string]*Texture
+}
+
+// Texture returns the texture of the frame of specified name.
+// nil if not found. Trimmed frames are drawn at their original position in
+// the sprite, and Size of the texture returns their original size.
+func (a *Atlas) Texture(name string) *Texture {
+	if t, ok := a.textures[name]; ok {
+		return t
+	}
+	pt := a.atlas.Texture(name)
+	if pt == nil {
+		return nil
+	}
+	t := &Texture{
+		simra:   a.simra,
+		texture: pt,
+	}
+	a.textures[name] = t
+	return t
+}
+
+// Names returns names of all frames in sorted order
+func (a *Atlas) Names() []string {
+	return a.atlas.Names()
+}
+
+// Release releases textures of all frames and the sheets.
+// Texture of a frame can also be released one by one, and a sheet is
+// released when all frames in it are released.
+func (a *Atlas) Release() {
+	simlog.FuncIn()
+	for _, name := range a.atlas.Names() {
+		a.simra.gl.ReleaseTexture(a.atlas.Texture(name))
+	}
+	simlog.FuncOut()
+}
+
+// NewAtlas loads TexturePacker's JSON data of specified asset, in hash or
+// array format, and its sheets. Frames can be taken as textures by name.
+// Rotated and trimmed frames are restored, and pages listed as related
+// multi packs are loaded together.
+func (sim *simra) NewAtlas(assetName string) (*Atlas, error) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	a, err := sim.gl.LoadAtlas(assetName)
+	if err != nil {
+		return nil, err
+	}
+	return &Atlas{
+		simra:    sim,
+		atlas:    a,
+		textures: make(map[string]*Texture),
+	}, nil
+}
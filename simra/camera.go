@@ -0,0 +1,233 @@
+package simra
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/pankona/gomo-simra/simra/internal/peer"
+)
+
+// Camera represents a view to the world.
+// Sprites are placed in world coordinates and camera transforms them into
+// the screen, except for sprites placed in screen space by SetScreenSpace.
+// Camera that is not moved, zoomed and rotated shows the world as it is,
+// that is, world coordinates are the same as virtual screen coordinates.
+type Camera interface {
+	// SetPosition sets world position that is shown at the center of screen.
+	SetPosition(x, y float32)
+	// GetPosition gets world position that is shown at the center of screen.
+	GetPosition() Position
+	// SetZoom sets zoom factor. 2 shows the world twice as large. Default is 1.
+	SetZoom(zoom float32)
+	// GetZoom gets zoom factor
+	GetZoom() float32
+	// SetRotation sets rotation of camera in radian.
+	// Positive value rotates the world clockwise on screen.
+	SetRotation(r float32)
+	// GetRotation gets rotation of camera
+	GetRotation() float32
+	// Follow makes camera follow specified sprite every frame.
+	// smoothing is the ratio to approach the target per frame, in 0..1.
+	// 1 means camera moves to the target immediately.
+	// Specifying nil stops following.
+	Follow(target Spriter, smoothing float32)
+	// SetDeadzone sets size of a rectangle at the center of screen,
+	// where the followed sprite can move without moving camera.
+	SetDeadzone(w, h float32)
+	// SetBounds limits camera so that it doesn't show outside of the rectangle
+	// in world coordinates. (x, y) is bottom left of the rectangle.
+	SetBounds(x, y, w, h float32)
+	// ClearBounds removes the limit specified by SetBounds.
+	ClearBounds()
+	// SetShake sets parameters of screen shake.
+	// maxOffset and maxAngle are offset and rotation at trauma 1,
+	// and decay is the amount of trauma reduced per frame.
+	SetShake(maxOffset, maxAngle, decay float32)
+	// AddTrauma adds trauma to shake screen. Trauma is clamped in 0..1
+	// and the strength of shake is proportional to square of trauma.
+	AddTrauma(trauma float32)
+	// GetTrauma gets current trauma
+	GetTrauma() float32
+	// ScreenToWorld converts specified position in virtual screen coordinates,
+	// such as touched position, to world coordinates.
+	ScreenToWorld(x, y float32) Position
+	// WorldToScreen converts specified position in world coordinates
+	// to virtual screen coordinates.
+	WorldToScreen(x, y float32) Position
+}
+
+type bounds struct {
+	x, y, w, h float32
+}
+
+type camera struct {
+	peer      *peer.Camera
+	target    Spriter
+	smoothing float32
+	deadzoneW float32
+	deadzoneH float32
+	bounds    *bounds
+
+	trauma    float32
+	maxOffset float32
+	maxAngle  float32
+	decay     float32
+	rand      *rand.Rand
+}
+
+func newCamera(c *peer.Camera) *camera {
+	c.Reset()
+	return &camera{
+		peer:      c,
+		maxOffset: 20,
+		maxAngle:  0.1,
+		decay:     0.02,
+		rand:      rand.New(rand.NewSource(1)),
+	}
+}
+
+// GetCamera returns the camera of current scene.
+func (sim *simra) GetCamera() Camera {
+	if sim.camera == nil {
+		sim.camera = newCamera(sim.gl.GetCamera())
+	}
+	return sim.camera
+}
+
+func (c *camera) SetPosition(x, y float32) {
+	c.moveTo(x, y)
+}
+
+func (c *camera) GetPosition() Position {
+	x, y := c.peer.GetPosition()
+	return Position{x, y}
+}
+
+func (c *camera) SetZoom(zoom float32) {
+	c.peer.SetZoom(zoom)
+	if c.bounds != nil {
+		p := c.GetPosition()
+		c.moveTo(p.X, p.Y)
+	}
+}
+
+func (c *camera) GetZoom() float32 {
+	return c.peer.GetZoom()
+}
+
+func (c *camera) SetRotation(r float32) {
+	c.peer.SetRotation(r)
+}
+
+func (c *camera) GetRotation() float32 {
+	return c.peer.GetRotation()
+}
+
+func (c *camera) Follow(target Spriter, smoothing float32) {
+	c.target = target
+	c.smoothing = smoothing
+}
+
+func (c *camera) SetDeadzone(w, h float32) {
+	c.deadzoneW, c.deadzoneH = w, h
+}
+
+func (c *camera) SetBounds(x, y, w, h float32) {
+	c.bounds = &bounds{x, y, w, h}
+	p := c.GetPosition()
+	c.moveTo(p.X, p.Y)
+}
+
+func (c *camera) ClearBounds() {
+	c.bounds = nil
+}
+
+func (c *camera) SetShake(maxOffset, maxAngle, decay float32) {
+	c.maxOffset, c.maxAngle, c.decay = maxOffset, maxAngle, decay
+}
+
+func (c *camera) AddTrauma(trauma float32) {
+	c.trauma = clamp(c.trauma+trauma, 0, 1)
+}
+
+func (c *camera) GetTrauma() float32 {
+	return c.trauma
+}
+
+func (c *camera) ScreenToWorld(x, y float32) Position {
+	wx, wy := c.peer.ScreenToWorld(x, y)
+	return Position{wx, wy}
+}
+
+func (c *camera) WorldToScreen(x, y float32) Position {
+	sx, sy := c.peer.WorldToScreen(x, y)
+	return Position{sx, sy}
+}
+
+// update moves camera to follow the target and shakes screen.
+// This is called every frame.
+func (c *camera) update() {
+	if s, ok := spriteOf(c.target); ok {
+		p := c.GetPosition()
+		tx, ty := s.Sprite.LocalToWorld(0, 0)
+		// move camera to keep the target in deadzone
+		t := clamp(c.smoothing, 0, 1)
+		c.moveTo(
+			p.X+deadzone(tx-p.X, c.deadzoneW/2)*t,
+			p.Y+deadzone(ty-p.Y, c.deadzoneH/2)*t)
+	}
+	c.shake()
+}
+
+// deadzone returns the distance to move for v to be inside of -half..half
+func deadzone(v, half float32) float32 {
+	switch {
+	case v > half:
+		return v - half
+	case v < -half:
+		return v + half
+	}
+	return 0
+}
+
+// moveTo moves camera to specified position keeping its view inside of bounds.
+// If bounds are smaller than the view, camera looks at the center of bounds.
+func (c *camera) moveTo(x, y float32) {
+	if c.bounds != nil {
+		w, h := c.peer.GetViewSize()
+		zoom := c.peer.GetZoom()
+		hw, hh := w/2/zoom, h/2/zoom
+		x = clampView(x, c.bounds.x, c.bounds.w, hw)
+		y = clampView(y, c.bounds.y, c.bounds.h, hh)
+	}
+	c.peer.SetPosition(x, y)
+}
+
+func clampView(v, min, length, half float32) float32 {
+	if length < half*2 {
+		return min + length/2
+	}
+	return clamp(v, min+half, min+length-half)
+}
+
+func (c *camera) shake() {
+	if c.trauma <= 0 {
+		c.peer.SetShakeOffset(0, 0, 0)
+		return
+	}
+	s := c.trauma * c.trauma
+	c.peer.SetShakeOffset(
+		c.maxOffset*s*c.noise(),
+		c.maxOffset*s*c.noise(),
+		c.maxAngle*s*c.noise())
+	c.trauma = clamp(c.trauma-c.decay, 0, 1)
+}
+
+// noise returns random value in -1..1
+func (c *camera) noise() float32 {
+	return c.rand.Float32()*2 - 1
+}
+
+func clamp(v, min, max float32) float32 {
+	return float32(math.Max(float64(min), math.Min(float64(max), float64(v))))
+}
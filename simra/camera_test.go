@@ -0,0 +1,90 @@
+package simra
+
+import (
+	"testing"
+
+	"github.com/pankona/gomo-simra/simra/internal/peer"
+)
+
+func newTestCamera() *camera {
+	peer.GetScreenSizePeer().SetDesiredScreenSize(200, 100)
+	return newCamera(peer.NewGLPeer().GetCamera())
+}
+
+func TestCameraFollow(t *testing.T) {
+	c := newTestCamera()
+
+	target := newTestSprite(100, 50, 10, 10)
+	c.Follow(target, 1)
+	c.SetDeadzone(40, 20)
+
+	// target moves inside of deadzone
+	target.SetPosition(115, 55)
+	c.update()
+	if p := c.GetPosition(); p.X != 100 || p.Y != 50 {
+		t.Errorf("unexpected result. [got] (%f, %f) [want] (%f, %f)", p.X, p.Y, 100.0, 50.0)
+	}
+
+	// camera moves to keep the target at the edge of deadzone
+	target.SetPosition(150, 50)
+	c.update()
+	if p := c.GetPosition(); p.X != 130 || p.Y != 50 {
+		t.Errorf("unexpected result. [got] (%f, %f) [want] (%f, %f)", p.X, p.Y, 130.0, 50.0)
+	}
+
+	// smoothing approaches the target gradually
+	c.Follow(target, 0.5)
+	c.SetDeadzone(0, 0)
+	c.update()
+	if p := c.GetPosition(); p.X != 140 {
+		t.Errorf("unexpected result. [got] %f [want] %f", p.X, 140.0)
+	}
+}
+
+func TestCameraBounds(t *testing.T) {
+	c := newTestCamera()
+
+	c.SetBounds(0, 0, 1000, 100)
+	c.SetPosition(-100, 300)
+	if p := c.GetPosition(); p.X != 100 || p.Y != 50 {
+		t.Errorf("unexpected result. [got] (%f, %f) [want] (%f, %f)", p.X, p.Y, 100.0, 50.0)
+	}
+	c.SetPosition(2000, 50)
+	if p := c.GetPosition(); p.X != 900 {
+		t.Errorf("unexpected result. [got] %f [want] %f", p.X, 900.0)
+	}
+
+	// zoom makes the view small
+	c.SetZoom(2)
+	c.SetPosition(2000, 50)
+	if p := c.GetPosition(); p.X != 950 {
+		t.Errorf("unexpected result. [got] %f [want] %f", p.X, 950.0)
+	}
+}
+
+func TestCameraShake(t *testing.T) {
+	c := newTestCamera()
+
+	c.SetShake(10, 0.1, 0.25)
+	c.AddTrauma(0.7)
+	c.AddTrauma(0.7)
+	if c.GetTrauma() != 1 {
+		t.Errorf("unexpected result. [got] %f [want] %f", c.GetTrauma(), 1.0)
+	}
+
+	for i := 0; i < 4; i++ {
+		c.update()
+		// shake doesn't move camera's position
+		if p := c.GetPosition(); p.X != 100 || p.Y != 50 {
+			t.Errorf("unexpected result. [got] (%f, %f) [want] (%f, %f)", p.X, p.Y, 100.0, 50.0)
+		}
+	}
+	if c.GetTrauma() != 0 {
+		t.Errorf("unexpected result. [got] %f [want] %f", c.GetTrauma(), 0.0)
+	}
+	c.update()
+	p := c.WorldToScreen(100, 50)
+	if p.X != 100 || p.Y != 50 {
+		t.Errorf("shake should be stopped. [got] (%f, %f)", p.X, p.Y)
+	}
+}
@@ -116,3 +116,27 @@ func TestRemoveCollisionListener(t *testing.T) {
 		t.Error("unexpected comap length. comapLength() =", simra.comapLength())
 	}
 }
+
+type hiddenCollider struct {
+	c
+	visible bool
+}
+
+func (h *hiddenCollider) IsVisible() bool {
+	return h.visible
+}
+
+func TestHiddenColliderIsSkipped(t *testing.T) {
+	var c1 c
+	c2 := &hiddenCollider{}
+	var li l
+
+	simra := &simra{}
+	simra.AddCollisionListener(&c1, c2, &li)
+	simra.collisionCheckAndNotify()
+	waitOnCollision(t, false)
+
+	c2.visible = true
+	simra.collisionCheckAndNotify()
+	waitOnCollision(t, true)
+}
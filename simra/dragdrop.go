@@ -0,0 +1,242 @@
+package simra
+
+import (
+	"math"
+
+	"github.com/pankona/gomo-simra/simra/simlog"
+)
+
+// DragListener is interface to receive drag events of a draggable sprite.
+type DragListener interface {
+	// OnDragStart is called when the sprite starts to be dragged,
+	// that is, touch is moved more than the threshold.
+	OnDragStart(s Spriter)
+	// OnDragEnd is called when the sprite is dropped.
+	// target is the drop target under the touch, or nil if there is no target.
+	// If the drop is not accepted, the sprite is already moved back to
+	// the position where dragging started.
+	OnDragEnd(s Spriter, target Spriter, accepted bool)
+}
+
+// DropTargetListener is interface to receive events of a drop target.
+type DropTargetListener interface {
+	// OnDragEnter is called when a dragged sprite comes over the target.
+	// This is a chance to highlight the target.
+	OnDragEnter(target, dragged Spriter)
+	// OnDragLeave is called when a dragged sprite goes out of the target,
+	// or is dropped on the target.
+	OnDragLeave(target, dragged Spriter)
+	// OnDrop is called when a dragged sprite is dropped on the target.
+	// Returning false rejects the drop, and the dragged sprite snaps back.
+	OnDrop(target, dragged Spriter) bool
+}
+
+type dropTarget struct {
+	sprite   Spriter
+	listener DropTargetListener
+}
+
+// draggable is a touch listener that makes a sprite draggable.
+type draggable struct {
+	simra     *simra
+	sprite    Spriter
+	threshold float32
+	listener  DragListener
+
+	pressed  bool
+	dragging bool
+	startX   float32
+	startY   float32
+	offsetX  float32
+	offsetY  float32
+	origin   Position
+	hovered  *dropTarget
+}
+
+// OnTouchBegin is called when draggable sprite is touched.
+func (d *draggable) OnTouchBegin(x, y float32) {
+	simlog.FuncIn()
+	p := d.sprite.GetPosition()
+	d.pressed = true
+	d.dragging = false
+	d.startX, d.startY = x, y
+	px, py := d.toParentSpace(x, y)
+	d.offsetX, d.offsetY = p.X-px, p.Y-py
+	d.origin = p
+	simlog.FuncOut()
+}
+
+// OnTouchMove is called when touch is moved.
+// Since the sprite captures the touch, this is called even if
+// the touch goes out of the sprite.
+func (d *draggable) OnTouchMove(x, y float32) {
+	if !d.pressed {
+		return
+	}
+	if !d.dragging {
+		dx, dy := x-d.startX, y-d.startY
+		if float32(math.Sqrt(float64(dx*dx+dy*dy))) < d.threshold {
+			return
+		}
+		d.dragging = true
+		if d.listener != nil {
+			d.listener.OnDragStart(d.sprite)
+		}
+	}
+
+	px, py := d.toParentSpace(x, y)
+	d.sprite.SetPosition(px+d.offsetX, py+d.offsetY)
+
+	target := d.simra.findDropTarget(x, y, d.sprite)
+	if target == d.hovered {
+		return
+	}
+	if d.hovered != nil {
+		d.hovered.listener.OnDragLeave(d.hovered.sprite, d.sprite)
+	}
+	if target != nil {
+		target.listener.OnDragEnter(target.sprite, d.sprite)
+	}
+	d.hovered = target
+}
+
+// OnTouchEnd is called when touch is released.
+func (d *draggable) OnTouchEnd(x, y float32) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	dragging := d.dragging
+	d.pressed, d.dragging = false, false
+	if !dragging {
+		return
+	}
+
+	px, py := d.toParentSpace(x, y)
+	d.sprite.SetPosition(px+d.offsetX, py+d.offsetY)
+	if d.hovered != nil {
+		d.hovered.listener.OnDragLeave(d.hovered.sprite, d.sprite)
+		d.hovered = nil
+	}
+
+	var targetSprite Spriter
+	accepted := false
+	target := d.simra.findDropTarget(x, y, d.sprite)
+	if target != nil {
+		targetSprite = target.sprite
+		accepted = target.listener.OnDrop(target.sprite, d.sprite)
+	}
+	if !accepted {
+		// snap back
+		d.sprite.SetPosition(d.origin.X, d.origin.Y)
+	}
+	if d.listener != nil {
+		d.listener.OnDragEnd(d.sprite, targetSprite, accepted)
+	}
+}
+
+// toParentSpace converts touched position to the coordinates where
+// the position of sprite is specified, considering camera and parent of sprite.
+func (d *draggable) toParentSpace(x, y float32) (float32, float32) {
+	s, ok := spriteOf(d.sprite)
+	if !ok {
+		return x, y
+	}
+	if !s.IsScreenSpace() {
+		x, y = s.Sprite.CameraAt(x, y).ScreenToWorld(x, y)
+	}
+	if p := s.Sprite.GetParent(); p != nil {
+		return p.WorldToLocal(x, y)
+	}
+	return x, y
+}
+
+// ConsumeTouch stops propagation of touch to sprites behind the draggable sprite.
+func (d *draggable) ConsumeTouch() bool {
+	return true
+}
+
+// findDropTarget returns the front-most drop target that contains
+// specified position, except specified sprite.
+func (sim *simra) findDropTarget(x, y float32, except Spriter) *dropTarget {
+	var found *dropTarget
+	var foundZ, foundOrder int
+	for _, t := range sim.dropTargets {
+		if t.sprite == except {
+			continue
+		}
+		sp, ok := spriteOf(t.sprite)
+		if !ok || !sp.Contains(x, y) {
+			continue
+		}
+		z, err := sim.GetZIndex(t.sprite)
+		if err != nil {
+			// not added to scene
+			continue
+		}
+		// layer of greater order is in front, and
+		// lesser zindex is in front in the same layer.
+		// for the same zindex, target registered later wins.
+		order := sp.Sprite.GetLayer().GetOrder()
+		if found == nil || order > foundOrder || (order == foundOrder && z <= foundZ) {
+			found, foundZ, foundOrder = t, z, order
+		}
+	}
+	return found
+}
+
+// AddDraggable makes specified sprite draggable.
+// Dragging starts when touch on the sprite moves more than threshold,
+// then the sprite follows the touch keeping the offset from touched position.
+// listener can be nil if drag events are not necessary.
+func (sim *simra) AddDraggable(s Spriter, threshold float32, listener DragListener) {
+	simlog.FuncIn()
+	sim.RemoveDraggable(s)
+	d := &draggable{
+		simra:     sim,
+		sprite:    s,
+		threshold: threshold,
+		listener:  listener,
+	}
+	sim.draggables = append(sim.draggables, d)
+	s.AddTouchListener(d)
+	simlog.FuncOut()
+}
+
+// RemoveDraggable makes specified sprite not draggable.
+func (sim *simra) RemoveDraggable(s Spriter) {
+	simlog.FuncIn()
+	var draggables []*draggable
+	for _, d := range sim.draggables {
+		if d.sprite == s {
+			s.RemoveTouchListener(d)
+			continue
+		}
+		draggables = append(draggables, d)
+	}
+	sim.draggables = draggables
+	simlog.FuncOut()
+}
+
+// AddDropTarget registers specified sprite as a drop target.
+func (sim *simra) AddDropTarget(s Spriter, listener DropTargetListener) {
+	simlog.FuncIn()
+	sim.RemoveDropTarget(s)
+	sim.dropTargets = append(sim.dropTargets, &dropTarget{
+		sprite:   s,
+		listener: listener,
+	})
+	simlog.FuncOut()
+}
+
+// RemoveDropTarget unregisters specified drop target.
+func (sim *simra) RemoveDropTarget(s Spriter) {
+	simlog.FuncIn()
+	var targets []*dropTarget
+	for _, t := range sim.dropTargets {
+		if t.sprite != s {
+			targets = append(targets, t)
+		}
+	}
+	sim.dropTargets = targets
+	simlog.FuncOut()
+}
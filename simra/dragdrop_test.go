@@ -0,0 +1,167 @@
+package simra
+
+import (
+	"testing"
+
+	"github.com/pankona/gomo-simra/simra/internal/peer"
+)
+
+type mockSpriteContainer struct {
+	peer.SpriteContainerer
+	zindex map[*peer.Sprite]int
+}
+
+func (m *mockSpriteContainer) GetZIndex(s *peer.Sprite) (int, error) {
+	return m.zindex[s], nil
+}
+
+type dragRecorder struct {
+	started  int
+	target   Spriter
+	accepted bool
+	ended    int
+}
+
+func (r *dragRecorder) OnDragStart(s Spriter) {
+	r.started++
+}
+
+func (r *dragRecorder) OnDragEnd(s Spriter, target Spriter, accepted bool) {
+	r.ended++
+	r.target = target
+	r.accepted = accepted
+}
+
+type dropRecorder struct {
+	accept  bool
+	entered int
+	left    int
+	dropped int
+}
+
+func (r *dropRecorder) OnDragEnter(target, dragged Spriter) {
+	r.entered++
+}
+
+func (r *dropRecorder) OnDragLeave(target, dragged Spriter) {
+	r.left++
+}
+
+func (r *dropRecorder) OnDrop(target, dragged Spriter) bool {
+	r.dropped++
+	return r.accept
+}
+
+func newTestSprite(x, y, w, h float32) *sprite {
+	s := &sprite{}
+	s.SetPosition(x, y)
+	s.SetScale(w, h)
+	return s
+}
+
+func TestDragAndDrop(t *testing.T) {
+	sim := &simra{
+		spritecontainer: &mockSpriteContainer{zindex: map[*peer.Sprite]int{}},
+	}
+
+	item := newTestSprite(50, 50, 20, 20)
+	slot := newTestSprite(200, 200, 50, 50)
+	drag := &dragRecorder{}
+	drop := &dropRecorder{accept: true}
+	sim.AddDraggable(item, 10, drag)
+	sim.AddDropTarget(slot, drop)
+	d := sim.draggables[0]
+
+	// movement less than threshold doesn't start dragging
+	d.OnTouchBegin(55, 50)
+	d.OnTouchMove(60, 50)
+	if drag.started != 0 || item.GetPosition().X != 50 {
+		t.Fatalf("drag should not be started")
+	}
+
+	// sprite follows touch keeping the offset
+	d.OnTouchMove(75, 50)
+	if drag.started != 1 {
+		t.Fatalf("drag should be started")
+	}
+	if p := item.GetPosition(); p.X != 70 || p.Y != 50 {
+		t.Errorf("unexpected position. [got] (%f, %f) [want] (%f, %f)", p.X, p.Y, 70.0, 50.0)
+	}
+
+	// hover on drop target
+	d.OnTouchMove(205, 200)
+	if drop.entered != 1 {
+		t.Errorf("drop target should be entered")
+	}
+	d.OnTouchMove(210, 200)
+	if drop.entered != 1 {
+		t.Errorf("enter should not be notified twice")
+	}
+
+	// accepted drop keeps position
+	d.OnTouchEnd(205, 200)
+	if drop.dropped != 1 || drop.left != 1 {
+		t.Errorf("unexpected result. [got] dropped=%d left=%d [want] dropped=%d left=%d", drop.dropped, drop.left, 1, 1)
+	}
+	if drag.ended != 1 || !drag.accepted || drag.target != slot {
+		t.Errorf("unexpected drag end. [got] ended=%d accepted=%t", drag.ended, drag.accepted)
+	}
+	if p := item.GetPosition(); p.X != 200 || p.Y != 200 {
+		t.Errorf("unexpected position. [got] (%f, %f) [want] (%f, %f)", p.X, p.Y, 200.0, 200.0)
+	}
+
+	// rejected drop snaps back
+	drop.accept = false
+	d.OnTouchBegin(200, 200)
+	d.OnTouchMove(300, 300)
+	d.OnTouchMove(200, 200)
+	d.OnTouchEnd(200, 200)
+	if drag.accepted {
+		t.Errorf("drop should be rejected")
+	}
+	if p := item.GetPosition(); p.X != 200 || p.Y != 200 {
+		t.Errorf("unexpected position. [got] (%f, %f) [want] (%f, %f)", p.X, p.Y, 200.0, 200.0)
+	}
+
+	// drop on no target snaps back
+	d.OnTouchBegin(200, 200)
+	d.OnTouchMove(400, 400)
+	d.OnTouchEnd(400, 400)
+	if drag.target != nil || drag.accepted {
+		t.Errorf("drop on no target should not be accepted")
+	}
+	if p := item.GetPosition(); p.X != 200 || p.Y != 200 {
+		t.Errorf("unexpected position. [got] (%f, %f) [want] (%f, %f)", p.X, p.Y, 200.0, 200.0)
+	}
+
+	sim.RemoveDraggable(item)
+	if len(sim.draggables) != 0 {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(sim.draggables), 0)
+	}
+}
+
+func TestFindDropTarget(t *testing.T) {
+	sc := &mockSpriteContainer{zindex: map[*peer.Sprite]int{}}
+	sim := &simra{spritecontainer: sc}
+
+	back := newTestSprite(100, 100, 100, 100)
+	front := newTestSprite(100, 100, 100, 100)
+	sc.zindex[&back.Sprite] = 1
+	sc.zindex[&front.Sprite] = 0
+
+	sim.AddDropTarget(front, &dropRecorder{})
+	sim.AddDropTarget(back, &dropRecorder{})
+
+	if target := sim.findDropTarget(100, 100, nil); target == nil || target.sprite != front {
+		t.Errorf("front-most target should be found")
+	}
+	if target := sim.findDropTarget(100, 100, front); target == nil || target.sprite != back {
+		t.Errorf("excepted sprite should be skipped")
+	}
+
+	sim.RemoveDropTarget(front)
+	sim.RemoveDropTarget(back)
+	if target := sim.findDropTarget(100, 100, nil); target != nil {
+		t.Errorf("removed target should not be found")
+	}
+}
@@ -0,0 +1,306 @@
+package peer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	"io/ioutil"
+	"path"
+	"sort"
+
+	"github.com/pankona/gomo-simra/simra/simlog"
+	"golang.org/x/mobile/asset"
+	"golang.org/x/mobile/exp/sprite"
+)
+
+// Atlas represents textures of frames packed into sheets by TexturePacker
+type Atlas struct {
+	textures map[string]*Texture
+	// names are names of frames in sorted order
+	names []string
+}
+
+// Texture returns the texture of the frame of specified name.
+// nil if not found.
+func (a *Atlas) Texture(name string) *Texture {
+	return a.textures[name]
+}
+
+// Names returns names of all frames in sorted order
+func (a *Atlas) Names() []string {
+	return append([]string(nil), a.names...)
+}
+
+// atlasRect is a rectangle in TexturePacker's JSON data
+type atlasRect struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+// atlasFrame is a frame in TexturePacker's JSON data.
+// Filename is only used by array format.
+type atlasFrame struct {
+	Filename         string    `json:"filename"`
+	Frame            atlasRect `json:"frame"`
+	Rotated          bool      `json:"rotated"`
+	Trimmed          bool      `json:"trimmed"`
+	SpriteSourceSize atlasRect `json:"spriteSourceSize"`
+	SourceSize       struct {
+		W int `json:"w"`
+		H int `json:"h"`
+	} `json:"sourceSize"`
+}
+
+// atlasPage is a sheet and frames packed into it
+type atlasPage struct {
+	Image  string          `json:"image"`
+	Frames json.RawMessage `json:"frames"`
+}
+
+// atlasData is TexturePacker's JSON data.
+// Frames are in the top level for JSON (Hash) and JSON (Array) formats,
+// while multi pack data of Phaser 3 format lists pages in textures.
+type atlasData struct {
+	Frames   json.RawMessage `json:"frames"`
+	Textures []atlasPage     `json:"textures"`
+	Meta     struct {
+		Image             string   `json:"image"`
+		RelatedMultiPacks []string `json:"related_multi_packs"`
+	} `json:"meta"`
+}
+
+// parseFrames parses frames of hash or array format
+func parseFrames(data json.RawMessage) ([]atlasFrame, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, nil
+	}
+	if data[0] == '[' {
+		var frames []atlasFrame
+		if err := json.Unmarshal(data, &frames); err != nil {
+			return nil, err
+		}
+		return frames, nil
+	}
+	hash := make(map[string]atlasFrame)
+	if err := json.Unmarshal(data, &hash); err != nil {
+		return nil, err
+	}
+	frames := make([]atlasFrame, 0, len(hash))
+	for name, f := range hash {
+		f.Filename = name
+		frames = append(frames, f)
+	}
+	sort.Slice(frames, func(i, j int) bool {
+		return frames[i].Filename < frames[j].Filename
+	})
+	return frames, nil
+}
+
+// atlasLoader loads atlas data and sheets.
+// open and load are replaceable for testing.
+type atlasLoader struct {
+	// open returns content of specified asset
+	open func(name string) ([]byte, error)
+	// load loads specified image as a texture, that is restored by reload
+	load func(img image.Image, reload func() (image.Image, error)) (sprite.Texture, error)
+	// atlas is the atlas being loaded
+	atlas *Atlas
+	// loaded are data files already loaded
+	loaded map[string]bool
+}
+
+// loadAtlas loads atlas of specified data file and related multi packs
+func (l *atlasLoader) loadAtlas(name string) (*Atlas, error) {
+	l.atlas = &Atlas{textures: make(map[string]*Texture)}
+	l.loaded = make(map[string]bool)
+	if err := l.loadData(name); err != nil {
+		return nil, err
+	}
+	for name := range l.atlas.textures {
+		l.atlas.names = append(l.atlas.names, name)
+	}
+	sort.Strings(l.atlas.names)
+	return l.atlas, nil
+}
+
+// loadData loads pages of specified data file.
+// Paths of sheets and related data are relative to the data file.
+func (l *atlasLoader) loadData(name string) error {
+	if l.loaded[name] {
+		return nil
+	}
+	l.loaded[name] = true
+
+	b, err := l.open(name)
+	if err != nil {
+		return err
+	}
+	var data atlasData
+	if err := json.Unmarshal(b, &data); err != nil {
+		return fmt.Errorf("failed to parse atlas %s: %v", name, err)
+	}
+
+	dir := path.Dir(name)
+	pages := data.Textures
+	if data.Meta.Image != "" {
+		pages = append(pages, atlasPage{Image: data.Meta.Image, Frames: data.Frames})
+	}
+	for _, p := range pages {
+		if err := l.loadPage(path.Join(dir, p.Image), p.Frames); err != nil {
+			return err
+		}
+	}
+	for _, related := range data.Meta.RelatedMultiPacks {
+		if err := l.loadData(path.Join(dir, related)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadPage loads the sheet once and makes textures of its frames.
+// A frame that appears in more than one page is taken from the first one.
+func (l *atlasLoader) loadPage(sheet string, data json.RawMessage) error {
+	frames, err := parseFrames(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse frames of %s: %v", sheet, err)
+	}
+	img, err := l.decode(sheet)
+	if err != nil {
+		return err
+	}
+	t, err := l.load(img, func() (image.Image, error) {
+		return l.decode(sheet)
+	})
+	if err != nil {
+		return err
+	}
+
+	// sheet is released when all frames in it are released
+	sheetEntry := &textureEntry{name: sheet, tex: t}
+	for _, f := range frames {
+		if _, ok := l.atlas.textures[f.Filename]; ok {
+			continue
+		}
+		// rotated frame is drawn turning the area in the sheet
+		tex := &Texture{
+			subTex:  sprite.SubTex{T: t, R: f.rect()},
+			rotated: f.Rotated,
+			entry:   sheetEntry,
+		}
+		sheetEntry.refs++
+		if f.Trimmed {
+			// untrimmed image placed around the frame
+			s := f.SpriteSourceSize
+			min := tex.subTex.R.Min.Sub(image.Pt(s.X, s.Y))
+			tex.source = image.Rectangle{
+				Min: min,
+				Max: min.Add(image.Pt(f.SourceSize.W, f.SourceSize.H)),
+			}
+		}
+		l.atlas.textures[f.Filename] = tex
+	}
+	return nil
+}
+
+// decode returns the image of specified sheet
+func (l *atlasLoader) decode(sheet string) (image.Image, error) {
+	b, err := l.open(sheet)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %v", sheet, err)
+	}
+	return img, nil
+}
+
+// rect returns area of the frame in the sheet.
+// Rotated frame occupies the area whose width and height are swapped.
+func (f *atlasFrame) rect() image.Rectangle {
+	r := f.Frame
+	if f.Rotated {
+		return image.Rect(r.X, r.Y, r.X+r.H, r.Y+r.W)
+	}
+	return image.Rect(r.X, r.Y, r.X+r.W, r.Y+r.H)
+}
+
+// unrotate returns an image of the frame at r, that is rotated
+// by 90 degrees clockwise in the sheet.
+func unrotate(img image.Image, r image.Rectangle) *image.RGBA {
+	w, h := r.Dy(), r.Dx()
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			dst.Set(x, y, img.At(r.Min.X+h-1-y, r.Min.Y+x))
+		}
+	}
+	return dst
+}
+
+// rotate returns an image of img rotated by 90 degrees clockwise,
+// as rotated frames are stored in the sheet
+func rotate(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	w, h := b.Dy(), b.Dx()
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			dst.Set(x, y, img.At(b.Min.X+y, b.Max.Y-1-x))
+		}
+	}
+	return dst
+}
+
+// decodeAsset returns the image of specified asset
+func decodeAsset(name string) (image.Image, error) {
+	b, err := readAsset(name)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %v", name, err)
+	}
+	return img, nil
+}
+
+// readAsset returns content of specified asset
+func readAsset(name string) ([]byte, error) {
+	a, err := asset.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		closeErr := a.Close()
+		if closeErr != nil {
+			simlog.Error(closeErr)
+		}
+	}()
+	return ioutil.ReadAll(a)
+}
+
+// LoadAtlas loads TexturePacker's JSON data of specified asset, in hash or
+// array format, and returns textures of its frames. Sheets are loaded once
+// and shared by the textures. Pages listed in the data as related multi packs
+// are loaded together.
+func (glpeer *GLPeer) LoadAtlas(assetName string) (*Atlas, error) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+
+	l := &atlasLoader{
+		open: readAsset,
+		load: func(img image.Image, reload func() (image.Image, error)) (sprite.Texture, error) {
+			return glpeer.eng.loadTexture(img, reload, "atlas:"+assetName)
+		},
+	}
+	return l.loadAtlas(assetName)
+}
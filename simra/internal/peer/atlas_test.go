@@ -0,0 +1,273 @@
+package peer
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"reflect"
+	"testing"
+
+	"golang.org/x/mobile/exp/f32"
+	"golang.org/x/mobile/exp/sprite"
+)
+
+// newTestAtlasLoader returns a loader that reads specified files
+// and loads images as textures on CPU
+func newTestAtlasLoader(files map[string][]byte) (*atlasLoader, *int) {
+	loads := 0
+	return &atlasLoader{
+		open: func(name string) ([]byte, error) {
+			b, ok := files[name]
+			if !ok {
+				return nil, fmt.Errorf("%s is not found", name)
+			}
+			return b, nil
+		},
+		load: func(img image.Image, reload func() (image.Image, error)) (sprite.Texture, error) {
+			loads++
+			b := img.Bounds()
+			return &texture{b: b, width: b.Dx(), height: b.Dy(), reload: reload}, nil
+		},
+	}, &loads
+}
+
+// encodeTestSheet returns a png of w x h whose pixel at (x, y) has red of x and green of y
+func encodeTestSheet(t *testing.T, w, h int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{uint8(x), uint8(y), 0, 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestLoadAtlasHash(t *testing.T) {
+	l, loads := newTestAtlasLoader(map[string][]byte{
+		"atlas/sheet.json": []byte(`{
+			"frames": {
+				"b.png": {
+					"frame": {"x": 10, "y": 0, "w": 4, "h": 6},
+					"rotated": false,
+					"trimmed": true,
+					"spriteSourceSize": {"x": 1, "y": 2, "w": 4, "h": 6},
+					"sourceSize": {"w": 8, "h": 10}
+				},
+				"a.png": {
+					"frame": {"x": 0, "y": 0, "w": 10, "h": 10},
+					"rotated": false,
+					"trimmed": false,
+					"spriteSourceSize": {"x": 0, "y": 0, "w": 10, "h": 10},
+					"sourceSize": {"w": 10, "h": 10}
+				}
+			},
+			"meta": {"image": "sheet.png"}
+		}`),
+		"atlas/sheet.png": encodeTestSheet(t, 16, 16),
+	})
+	a, err := l.loadAtlas("atlas/sheet.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *loads != 1 {
+		t.Errorf("sheet should be loaded once. [got] %d [want] %d", *loads, 1)
+	}
+	if got, want := a.Names(), []string{"a.png", "b.png"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+	if a.Texture("c.png") != nil {
+		t.Errorf("unknown frame should be nil")
+	}
+
+	tcs := []struct {
+		name   string
+		r      image.Rectangle
+		source image.Rectangle
+		w, h   int
+	}{
+		{name: "a.png", r: image.Rect(0, 0, 10, 10), w: 10, h: 10},
+		{name: "b.png", r: image.Rect(10, 0, 14, 6), source: image.Rect(9, -2, 17, 8), w: 8, h: 10},
+	}
+	for _, tc := range tcs {
+		tex := a.Texture(tc.name)
+		if tex.subTex.R != tc.r {
+			t.Errorf("[%s] unexpected result. [got] %v [want] %v", tc.name, tex.subTex.R, tc.r)
+		}
+		if tex.source != tc.source {
+			t.Errorf("[%s] unexpected result. [got] %v [want] %v", tc.name, tex.source, tc.source)
+		}
+		if w, h := tex.Size(); w != tc.w || h != tc.h {
+			t.Errorf("[%s] unexpected result. [got] %d, %d [want] %d, %d", tc.name, w, h, tc.w, tc.h)
+		}
+	}
+	if a.Texture("a.png").subTex.T != a.Texture("b.png").subTex.T {
+		t.Errorf("frames in a sheet should share the texture")
+	}
+}
+
+func TestLoadAtlasArrayRotated(t *testing.T) {
+	l, loads := newTestAtlasLoader(map[string][]byte{
+		"sheet.json": []byte(`{
+			"frames": [
+				{
+					"filename": "rotated.png",
+					"frame": {"x": 2, "y": 3, "w": 4, "h": 6},
+					"rotated": true,
+					"trimmed": false,
+					"spriteSourceSize": {"x": 0, "y": 0, "w": 4, "h": 6},
+					"sourceSize": {"w": 4, "h": 6}
+				}
+			],
+			"meta": {"image": "sheet.png"}
+		}`),
+		"sheet.png": encodeTestSheet(t, 16, 16),
+	})
+	a, err := l.loadAtlas("sheet.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tex := a.Texture("rotated.png")
+	// the frame is drawn from the area in the sheet, without another texture
+	if want := image.Rect(2, 3, 8, 7); tex.subTex.R != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", tex.subTex.R, want)
+	}
+	if *loads != 1 {
+		t.Errorf("unexpected result. [got] %d [want] %d", *loads, 1)
+	}
+	if w, h := tex.Size(); w != 4 || h != 6 {
+		t.Errorf("unexpected result. [got] %d x %d [want] %d x %d", w, h, 4, 6)
+	}
+	sheet := tex.subTex.T.(*texture).pixels()
+
+	// frame occupies (2, 3)-(8, 7) in the sheet, rotated clockwise.
+	// top left of the frame is at top right of the area.
+	tcs := []struct {
+		x, y   int
+		sx, sy int
+	}{
+		{x: 0, y: 0, sx: 7, sy: 3},
+		{x: 3, y: 0, sx: 7, sy: 6},
+		{x: 0, y: 5, sx: 2, sy: 3},
+		{x: 3, y: 5, sx: 2, sy: 6},
+	}
+	for _, ns := range []*NineSlice{nil, {Top: 1, Bottom: 2, Left: 1}} {
+		s := &Sprite{X: 2, Y: 3, W: 4, H: 6}
+		s.SetNineSlice(ns)
+		zn := &ZNode{Node: &sprite.Node{}, sprite: s}
+		zn.Node.EngineFields.SubTex = tex.subTex
+		zn.trim = tex.trim()
+		zn.rotated = tex.rotated
+
+		glpeer := &GLPeer{}
+		glpeer.znodes = ZNodes{zn}
+		glpeer.layoutDirty = true
+		rt, err := glpeer.NewRenderTarget(4, 6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rt.AddSprite(s)
+		glpeer.RenderTo(rt)
+		for i, tc := range tcs {
+			got := rt.Image().RGBAAt(tc.x, tc.y)
+			want := color.RGBA{uint8(tc.sx), uint8(tc.sy), 0, 255}
+			if got != want {
+				t.Errorf("[%v, %d] unexpected result. [got] %v [want] %v", ns, i, got, want)
+			}
+		}
+	}
+
+	// hit test refers the frame not rotated
+	sheet.Set(7, 3, color.Transparent)
+	m := tex.AlphaMask(1)
+	if m.Contains(-1.5, 2.5, 4, 6) {
+		t.Errorf("transparent pixel at top left of the frame should not be hit")
+	}
+	if !m.Contains(1.5, 2.5, 4, 6) {
+		t.Errorf("opaque pixel at top right of the frame should be hit")
+	}
+}
+
+func TestLoadAtlasMultiPack(t *testing.T) {
+	frame := func(name string) string {
+		return fmt.Sprintf(`{"filename": %q, "frame": {"x": 0, "y": 0, "w": 2, "h": 2}}`, name)
+	}
+	l, loads := newTestAtlasLoader(map[string][]byte{
+		"pack-0.json": []byte(`{
+			"frames": [` + frame("a.png") + `],
+			"meta": {"image": "pack-0.png", "related_multi_packs": ["sub/pack-1.json"]}
+		}`),
+		"sub/pack-1.json": []byte(`{
+			"frames": [` + frame("b.png") + `],
+			"meta": {"image": "pack-1.png", "related_multi_packs": ["../pack-0.json"]}
+		}`),
+		"pack-0.png":     encodeTestSheet(t, 2, 2),
+		"sub/pack-1.png": encodeTestSheet(t, 2, 2),
+		"multi.json": []byte(`{
+			"textures": [
+				{"image": "pack-0.png", "frames": [` + frame("a.png") + `]},
+				{"image": "sub/pack-1.png", "frames": [` + frame("b.png") + `]}
+			]
+		}`),
+	})
+	for _, name := range []string{"pack-0.json", "multi.json"} {
+		*loads = 0
+		a, err := l.loadAtlas(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := a.Names(), []string{"a.png", "b.png"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("[%s] unexpected result. [got] %v [want] %v", name, got, want)
+		}
+		if *loads != 2 {
+			t.Errorf("[%s] unexpected result. [got] %d [want] %d", name, *loads, 2)
+		}
+	}
+
+	if _, err := l.loadAtlas("missing.json"); err == nil {
+		t.Errorf("error should be returned for missing data")
+	}
+}
+
+func TestTextureTrim(t *testing.T) {
+	tex := &Texture{subTex: sprite.SubTex{R: image.Rect(10, 0, 14, 6)}}
+	if tex.trim() != nil {
+		t.Errorf("untrimmed texture should not have trim")
+	}
+
+	tex.source = image.Rect(9, -2, 17, 8)
+	want := f32.Affine{
+		{0.5, 0, 0.125},
+		{0, 0.6, 0.2},
+	}
+	got := tex.trim()
+	for i := range want {
+		for j := range want[i] {
+			if !nearlyEqual(got[i][j], want[i][j]) {
+				t.Errorf("unexpected result. [got] %v [want] %v", *got, want)
+			}
+		}
+	}
+
+	// trimmed area is transparent
+	img := image.NewRGBA(image.Rect(0, 0, 32, 32))
+	for y := 0; y < 32; y++ {
+		for x := 0; x < 32; x++ {
+			img.Set(x, y, color.RGBA{A: 255})
+		}
+	}
+	tex.subTex.T = &texture{rgba: img, b: img.Bounds(), width: 32, height: 32}
+	m := tex.AlphaMask(128)
+	// sprite of 8 x 10, origin at center
+	if !m.Contains(0, 0, 8, 10) {
+		t.Errorf("frame area should be hit")
+	}
+	if m.Contains(-3.5, 0, 8, 10) || m.Contains(0, -4.5, 8, 10) {
+		t.Errorf("trimmed area should not be hit")
+	}
+}
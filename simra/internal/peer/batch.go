@@ -0,0 +1,212 @@
+package peer
+
+import (
+	"encoding/binary"
+	"image"
+	"math"
+
+	"golang.org/x/mobile/event/size"
+	"golang.org/x/mobile/exp/f32"
+	"golang.org/x/mobile/exp/gl/glutil"
+	"golang.org/x/mobile/gl"
+)
+
+const (
+	// maxBatchQuads is the maximum number of quads drawn by a draw call.
+	// indices of vertices must fit in unsigned short.
+	maxBatchQuads = 4096
+	// batchVertexSize is size of a vertex in bytes. position, uv and tint.
+	batchVertexSize = (2 + 2 + 4) * 4
+)
+
+// batch accumulates quads that share a texture and render state,
+// to draw them in a single draw call.
+type batch struct {
+	tex     *texture
+	blend   BlendMode
+	scissor [4]int32
+	clipped bool
+	// vertices are vertices of quads in clip space
+	vertices []byte
+	// count is the number of quads in the batch
+	count int
+}
+
+// batchable returns true if the node can be drawn in a batch.
+// Masked nodes and nodes that have a material need their own draw call.
+func batchable(ns *nodeState) bool {
+	return ns.mask == nil && ns.material == nil
+}
+
+// accepts returns true if a quad of texture t drawn with ns can be
+// appended to the batch without flushing it.
+func (b *batch) accepts(t *texture, ns *nodeState) bool {
+	if b.count == 0 {
+		return true
+	}
+	return b.count < maxBatchQuads &&
+		b.tex == t &&
+		b.blend == ns.blend &&
+		b.clipped == ns.clipped &&
+		(!ns.clipped || b.scissor == ns.scissor)
+}
+
+// append appends a quad that draws sub rectangle r of texture t to the
+// parallelogram represented by mvp in clip space. uv maps the unit square to
+// the area drawn in r. nil draws whole r.
+// The batch must accept the quad.
+func (b *batch) append(t *texture, r image.Rectangle, uv, mvp *f32.Affine, ns *nodeState) {
+	if b.count == 0 {
+		b.tex = t
+		b.blend = ns.blend
+		b.clipped = ns.clipped
+		b.scissor = ns.scissor
+	}
+	uvp := cropUVP(calcUVP(r, t.width, t.height), uv)
+	// same corners as quadXYCoords and quadUVCoords
+	for i := 0; i < 4; i++ {
+		x, y := float32(i%2*2-1), float32(1-i/2*2)
+		u, v := float32(i%2), float32(i/2)
+		b.vertices = appendFloats(b.vertices,
+			mvp[0][0]*x+mvp[0][1]*y+mvp[0][2],
+			mvp[1][0]*x+mvp[1][1]*y+mvp[1][2],
+			uvp[0][0]*u+uvp[0][2],
+			uvp[1][1]*v+uvp[1][2],
+			ns.tint[0], ns.tint[1], ns.tint[2], ns.tint[3])
+	}
+	b.count++
+}
+
+// reset empties the batch keeping its buffer
+func (b *batch) reset() {
+	b.tex = nil
+	b.vertices = b.vertices[:0]
+	b.count = 0
+}
+
+func appendFloats(buf []byte, fs ...float32) []byte {
+	for _, f := range fs {
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], math.Float32bits(f))
+		buf = append(buf, b[:]...)
+	}
+	return buf
+}
+
+// batchIndices returns indices of triangles of maxBatchQuads quads
+func batchIndices() []byte {
+	buf := make([]byte, 0, maxBatchQuads*6*2)
+	for i := 0; i < maxBatchQuads; i++ {
+		base := uint16(i * 4)
+		// top left, top right, bottom left and bottom right
+		for _, j := range []uint16{0, 1, 2, 1, 3, 2} {
+			var b [2]byte
+			binary.LittleEndian.PutUint16(b[:], base+j)
+			buf = append(buf, b[:]...)
+		}
+	}
+	return buf
+}
+
+// batchProgram represents the program that draws batches and locations of its variables
+type batchProgram struct {
+	program gl.Program
+	pos     gl.Attrib
+	inUV    gl.Attrib
+	inTint  gl.Attrib
+	sample  gl.Uniform
+}
+
+func newBatchProgram(glctx gl.Context) (*batchProgram, error) {
+	program, err := glutil.CreateProgram(glctx, batchVertexShader, batchFragmentShader)
+	if err != nil {
+		return nil, err
+	}
+	return &batchProgram{
+		program: program,
+		pos:     glctx.GetAttribLocation(program, "pos"),
+		inUV:    glctx.GetAttribLocation(program, "inUV"),
+		inTint:  glctx.GetAttribLocation(program, "inTint"),
+		sample:  glctx.GetUniformLocation(program, "textureSample"),
+	}, nil
+}
+
+const batchVertexShader = `#version 100
+attribute vec2 pos;
+attribute vec2 inUV;
+attribute vec4 inTint;
+varying vec2 UV;
+varying vec4 tint;
+void main() {
+	gl_Position = vec4(pos, 0, 1);
+	UV = inUV;
+	tint = inTint;
+}
+`
+
+const batchFragmentShader = `#version 100
+precision mediump float;
+varying vec2 UV;
+varying vec4 tint;
+uniform sampler2D textureSample;
+void main() {
+	gl_FragColor = texture2D(textureSample, UV) * tint;
+}
+`
+
+// appendBatch appends a quad of the node to the batch, flushing the batch
+// if the quad can not be appended.
+func (e *engine) appendBatch(t *texture, r image.Rectangle, uv, m *f32.Affine, ns *nodeState, sz size.Event) {
+	if !e.batch.accepts(t, ns) {
+		e.flush()
+	}
+	mvp := e.calcMVP(m, sz)
+	e.batch.append(t, r, uv, &mvp, ns)
+}
+
+// flush draws quads in the batch by a draw call
+func (e *engine) flush() {
+	b := &e.batch
+	if b.count == 0 {
+		return
+	}
+	glctx := e.glctx
+	p := e.batchProgram
+
+	if b.clipped {
+		glctx.Enable(gl.SCISSOR_TEST)
+		glctx.Scissor(b.scissor[0], b.scissor[1], b.scissor[2], b.scissor[3])
+	} else {
+		glctx.Disable(gl.SCISSOR_TEST)
+	}
+	glctx.UseProgram(p.program)
+	e.current = nil
+
+	src, dst := b.blend.blendFunc()
+	glctx.Enable(gl.BLEND)
+	glctx.BlendEquation(gl.FUNC_ADD)
+	glctx.BlendFunc(src, dst)
+
+	glctx.ActiveTexture(gl.TEXTURE0)
+	glctx.BindTexture(gl.TEXTURE_2D, b.tex.gltex)
+	glctx.Uniform1i(p.sample, 0)
+
+	glctx.BindBuffer(gl.ARRAY_BUFFER, e.batchVertices)
+	glctx.BufferData(gl.ARRAY_BUFFER, b.vertices, gl.STREAM_DRAW)
+	glctx.EnableVertexAttribArray(p.pos)
+	glctx.VertexAttribPointer(p.pos, 2, gl.FLOAT, false, batchVertexSize, 0)
+	glctx.EnableVertexAttribArray(p.inUV)
+	glctx.VertexAttribPointer(p.inUV, 2, gl.FLOAT, false, batchVertexSize, 2*4)
+	glctx.EnableVertexAttribArray(p.inTint)
+	glctx.VertexAttribPointer(p.inTint, 4, gl.FLOAT, false, batchVertexSize, 4*4)
+
+	glctx.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, e.batchIndices)
+	glctx.DrawElements(gl.TRIANGLES, b.count*6, gl.UNSIGNED_SHORT, 0)
+	e.drawCalls++
+
+	glctx.DisableVertexAttribArray(p.pos)
+	glctx.DisableVertexAttribArray(p.inUV)
+	glctx.DisableVertexAttribArray(p.inTint)
+	glctx.Disable(gl.BLEND)
+	b.reset()
+}
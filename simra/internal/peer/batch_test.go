@@ -0,0 +1,320 @@
+package peer
+
+import (
+	"encoding/binary"
+	"image"
+	"math"
+	"testing"
+
+	"golang.org/x/mobile/event/size"
+	"golang.org/x/mobile/exp/f32"
+	"golang.org/x/mobile/exp/sprite"
+	"golang.org/x/mobile/exp/sprite/clock"
+	"golang.org/x/mobile/gl"
+)
+
+// mockGLContext is a gl.Context that does nothing but counting draw calls
+type mockGLContext struct {
+	gl.Context
+	draws int
+}
+
+func (m *mockGLContext) Enable(cap gl.Enum)                                      {}
+func (m *mockGLContext) Disable(cap gl.Enum)                                     {}
+func (m *mockGLContext) Scissor(x, y, width, height int32)                       {}
+func (m *mockGLContext) UseProgram(p gl.Program)                                 {}
+func (m *mockGLContext) Uniform1f(dst gl.Uniform, v float32)                     {}
+func (m *mockGLContext) Uniform1i(dst gl.Uniform, v int)                         {}
+func (m *mockGLContext) Uniform2f(dst gl.Uniform, v0, v1 float32)                {}
+func (m *mockGLContext) Uniform4f(dst gl.Uniform, v0, v1, v2, v3 float32)        {}
+func (m *mockGLContext) UniformMatrix3fv(dst gl.Uniform, src []float32)          {}
+func (m *mockGLContext) BlendEquation(mode gl.Enum)                              {}
+func (m *mockGLContext) BlendFunc(sfactor, dfactor gl.Enum)                      {}
+func (m *mockGLContext) ActiveTexture(texture gl.Enum)                           {}
+func (m *mockGLContext) BindTexture(target gl.Enum, t gl.Texture)                {}
+func (m *mockGLContext) BindBuffer(target gl.Enum, b gl.Buffer)                  {}
+func (m *mockGLContext) BufferData(target gl.Enum, src []byte, usage gl.Enum)    {}
+func (m *mockGLContext) EnableVertexAttribArray(a gl.Attrib)                     {}
+func (m *mockGLContext) DisableVertexAttribArray(a gl.Attrib)                    {}
+func (m *mockGLContext) DrawArrays(mode gl.Enum, first, count int)               { m.draws++ }
+func (m *mockGLContext) DrawElements(mode gl.Enum, count int, ty gl.Enum, o int) { m.draws++ }
+func (m *mockGLContext) VertexAttribPointer(dst gl.Attrib, size int, ty gl.Enum, normalized bool, stride, offset int) {
+}
+
+// newBatchTestEngine returns an engine that draws to specified context without compiling programs
+func newBatchTestEngine(glctx gl.Context) *engine {
+	return &engine{
+		glctx:        glctx,
+		def:          &shaderProgram{uniforms: make(map[string]gl.Uniform)},
+		programs:     make(map[string]*shaderProgram),
+		batchProgram: &batchProgram{},
+		nodes:        []*nodeState{nil},
+	}
+}
+
+// newBatchTestNode registers a node that draws specified texture
+func newBatchTestNode(e *engine, tex *texture) *sprite.Node {
+	n := &sprite.Node{}
+	e.Register(n)
+	e.SetSubTex(n, sprite.SubTex{T: tex, R: image.Rect(0, 0, 2, 2)})
+	e.SetTransform(n, f32.Affine{
+		{10, 0, 0},
+		{0, 10, 0},
+	})
+	return n
+}
+
+var batchTestSize = size.Event{WidthPx: 100, HeightPx: 100, WidthPt: 100, HeightPt: 100, PixelsPerPt: 1}
+
+func TestBatchVertices(t *testing.T) {
+	tex := &texture{width: 4, height: 4}
+	ns := &nodeState{tint: [4]float32{1, 1, 1, 1}}
+	// full screen quad
+	mvp := f32.Affine{
+		{1, 0, 0},
+		{0, 1, 0},
+	}
+	var b batch
+	b.append(tex, image.Rect(0, 0, 2, 2), nil, &mvp, ns)
+
+	want := [][8]float32{
+		{-1, +1, 0, 0, 1, 1, 1, 1},
+		{+1, +1, 0.5, 0, 1, 1, 1, 1},
+		{-1, -1, 0, 0.5, 1, 1, 1, 1},
+		{+1, -1, 0.5, 0.5, 1, 1, 1, 1},
+	}
+	if len(b.vertices) != len(want)*batchVertexSize {
+		t.Fatalf("unexpected length. [got] %d [want] %d", len(b.vertices), len(want)*batchVertexSize)
+	}
+	for i := range want {
+		var got [8]float32
+		for j := range got {
+			bits := binary.LittleEndian.Uint32(b.vertices[i*batchVertexSize+j*4:])
+			got[j] = math.Float32frombits(bits)
+		}
+		if got != want[i] {
+			t.Errorf("[%d] unexpected result. [got] %v [want] %v", i, got, want[i])
+		}
+	}
+}
+
+func TestBatchAccepts(t *testing.T) {
+	tex := &texture{width: 2, height: 2}
+	other := &texture{width: 2, height: 2}
+	mvp := f32.Affine{}
+	tcs := []struct {
+		tex  *texture
+		ns   nodeState
+		want bool
+	}{
+		{tex: tex, ns: nodeState{}, want: true},
+		{tex: other, ns: nodeState{}, want: false},
+		{tex: tex, ns: nodeState{blend: BlendAdditive}, want: false},
+		{tex: tex, ns: nodeState{clipped: true}, want: false},
+	}
+	for i, tc := range tcs {
+		var b batch
+		b.append(tex, image.Rect(0, 0, 2, 2), nil, &mvp, &nodeState{})
+		if got := b.accepts(tc.tex, &tc.ns); got != tc.want {
+			t.Errorf("[%d] unexpected result. [got] %v [want] %v", i, got, tc.want)
+		}
+	}
+}
+
+func TestRenderBatch(t *testing.T) {
+	glctx := &mockGLContext{}
+	e := newBatchTestEngine(glctx)
+	tex := &texture{width: 2, height: 2}
+	var nodes []*sprite.Node
+	for i := 0; i < 10; i++ {
+		nodes = append(nodes, newBatchTestNode(e, tex))
+	}
+	for _, n := range nodes {
+		e.Render(n, 0, batchTestSize)
+	}
+	e.flush()
+	if glctx.draws != 1 {
+		t.Errorf("sprites sharing a texture should be drawn at once. [got] %d [want] %d", glctx.draws, 1)
+	}
+
+	// node that has material breaks the batch
+	m := NewMaterial(defaultEffect)
+	e.programs[m.source] = &shaderProgram{uniforms: make(map[string]gl.Uniform)}
+	e.SetMaterial(nodes[5], m)
+	glctx.draws = 0
+	for _, n := range nodes {
+		e.Render(n, 0, batchTestSize)
+	}
+	e.flush()
+	if glctx.draws != 3 {
+		t.Errorf("unexpected result. [got] %d [want] %d", glctx.draws, 3)
+	}
+	if e.drawCalls != 4 {
+		t.Errorf("unexpected result. [got] %d [want] %d", e.drawCalls, 4)
+	}
+}
+
+func TestTransformCache(t *testing.T) {
+	parent := &Sprite{X: 10}
+	s := &Sprite{X: 1, Y: 2, W: 3, H: 4}
+	view := f32.Affine{
+		{1, 0, 0},
+		{0, 1, 0},
+	}
+	affine := s.transform()
+	var c transformCache
+	cached := func() bool {
+		c.sync(s)
+		return c.at(0, &view) != nil
+	}
+	if cached() {
+		t.Errorf("nothing should be cached at first")
+	}
+	c.store(0, &view, &affine)
+	if !cached() {
+		t.Errorf("transform without changes should be cached")
+	}
+
+	s.X = 5
+	if cached() {
+		t.Errorf("transform of moved sprite should be dropped")
+	}
+	c.store(0, &view, &affine)
+
+	parent.AddChild(s)
+	if cached() {
+		t.Errorf("transform of sprite that got parent should be dropped")
+	}
+	c.store(0, &view, &affine)
+	parent.R = 1
+	if cached() {
+		t.Errorf("transform of sprite whose parent rotated should be dropped")
+	}
+	c.store(0, &view, &affine)
+
+	// another viewport doesn't drop the transform of the first one
+	other := view
+	other[0][2] = 1
+	c.store(1, &other, &affine)
+	if !cached() || c.at(1, &other) == nil {
+		t.Errorf("transforms of viewports should be kept")
+	}
+	if c.at(0, &other) != nil {
+		t.Errorf("transform seen through moved view should not be used")
+	}
+
+	c.invalidate()
+	if cached() {
+		t.Errorf("invalidated cache should be dropped")
+	}
+}
+
+func TestStateCache(t *testing.T) {
+	defer useTestScreen(100, 100, 100, 100)()
+
+	s := &Sprite{X: 50, Y: 50, W: 10, H: 10}
+	glpeer, sc := newCullTestPeer(s)
+	vp := glpeer.getScene().defaultViewport
+	ns := glpeer.eng.nodes[sc.index[s].znode.Node.EngineFields.Index]
+	glpeer.apply(sc, vp, 0)
+	want := ns.tint
+
+	// unchanged state is not set again
+	ns.tint = [4]float32{}
+	ns.blend = BlendScreen
+	glpeer.apply(sc, vp, 0)
+	if ns.tint != ([4]float32{}) || ns.blend != BlendScreen {
+		t.Errorf("state of unchanged sprite should not be set again")
+	}
+
+	s.SetAlpha(0.5)
+	glpeer.apply(sc, vp, 0)
+	if ns.tint == ([4]float32{}) || ns.blend != BlendNormal {
+		t.Errorf("state of changed sprite should be set")
+	}
+
+	s.SetAlpha(1)
+	sc.index[s].znode.state.invalidate()
+	ns.tint = [4]float32{}
+	glpeer.apply(sc, vp, 0)
+	if ns.tint != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", ns.tint, want)
+	}
+}
+
+// newBenchmarkPeer returns GLPeer and SpriteContainer that have n sprites.
+// Textures are shared by all sprites if shared is true.
+func newBenchmarkPeer(b *testing.B, n int, shared bool) (*GLPeer, *SpriteContainer) {
+	glpeer := &GLPeer{eng: newBatchTestEngine(&mockGLContext{})}
+	sc := &SpriteContainer{gl: glpeer}
+	tex := &texture{width: 32, height: 32}
+	for i := 0; i < n; i++ {
+		if !shared {
+			tex = &texture{width: 32, height: 32}
+		}
+		s := &Sprite{X: float32(i % 100), Y: float32(i / 100), W: 32, H: 32}
+		err := sc.AddSprite(s, &sprite.SubTex{T: tex, R: image.Rect(0, 0, 32, 32)}, nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	glpeer.arrange()
+	return glpeer, sc
+}
+
+func BenchmarkApply(b *testing.B) {
+	defer useTestScreen(1000, 1000, 1000, 1000)()
+	b.Run("static", func(b *testing.B) {
+		glpeer, sc := newBenchmarkPeer(b, 2000, true)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			glpeer.apply(sc, glpeer.getScene().defaultViewport, 0)
+		}
+	})
+	// static sprites whose state is set every frame, as before the state was cached
+	b.Run("static uncached", func(b *testing.B) {
+		glpeer, sc := newBenchmarkPeer(b, 2000, true)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, sn := range sc.GetSpriteNodePairs() {
+				sn.znode.state.invalidate()
+			}
+			glpeer.apply(sc, glpeer.getScene().defaultViewport, 0)
+		}
+	})
+	b.Run("moving", func(b *testing.B) {
+		glpeer, sc := newBenchmarkPeer(b, 2000, true)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, sn := range sc.GetSpriteNodePairs() {
+				sn.sprite.X++
+			}
+			glpeer.apply(sc, glpeer.getScene().defaultViewport, 0)
+		}
+	})
+}
+
+func BenchmarkRender(b *testing.B) {
+	defer useTestScreen(1000, 1000, 1000, 1000)()
+	render := func(b *testing.B, shared bool) {
+		glpeer, sc := newBenchmarkPeer(b, 2000, shared)
+		glpeer.apply(sc, glpeer.getScene().defaultViewport, 0)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			glpeer.eng.drawCalls = 0
+			for _, znodes := range glpeer.layerNodes {
+				for _, zn := range znodes {
+					glpeer.eng.Render(zn.Node, clock.Time(i), batchTestSize)
+				}
+			}
+			glpeer.eng.flush()
+		}
+		b.ReportMetric(float64(glpeer.eng.drawCalls), "draws/frame")
+	}
+	b.Run("shared texture", func(b *testing.B) {
+		render(b, true)
+	})
+	b.Run("distinct textures", func(b *testing.B) {
+		render(b, false)
+	})
+}
@@ -0,0 +1,127 @@
+package peer
+
+import (
+	"math"
+
+	"golang.org/x/mobile/exp/f32"
+)
+
+// Camera represents a view to the world.
+// Sprites are placed in world coordinates, and camera transforms them
+// into virtual screen coordinates, except for screen-space sprites.
+// Camera that is not moved, zoomed and rotated shows the world
+// as it is, that is, world coordinates are the same as virtual screen coordinates.
+type Camera struct {
+	// viewport is the viewport that owns camera. nil for the main camera,
+	// whose view is whole screen.
+	viewport *Viewport
+	// positioned is false until position is specified.
+	// while false, camera looks at the center of screen.
+	positioned bool
+	x, y       float32
+	// zoom is zoom factor minus 1. zero value means no zoom.
+	zoom float32
+	r    float32
+	// shake offset added to position and rotation
+	shakeX, shakeY, shakeR float32
+}
+
+// GetCamera returns the main camera.
+func (glpeer *GLPeer) GetCamera() *Camera {
+	return glpeer.getScene().camera
+}
+
+// Reset resets position, zoom, rotation and shake of camera.
+func (c *Camera) Reset() {
+	*c = Camera{viewport: c.viewport}
+}
+
+// viewCenter returns the center of camera's view in virtual screen coordinates.
+func (c *Camera) viewCenter() (x, y float32) {
+	if c.viewport == nil {
+		return screensize.width / 2, screensize.height / 2
+	}
+	return c.viewport.x + c.viewport.w/2, c.viewport.y + c.viewport.h/2
+}
+
+// SetPosition sets world position that is shown at the center of the view.
+func (c *Camera) SetPosition(x, y float32) {
+	c.x, c.y = x, y
+	c.positioned = true
+}
+
+// GetPosition returns world position that is shown at the center of the view.
+func (c *Camera) GetPosition() (x, y float32) {
+	if !c.positioned {
+		return c.viewCenter()
+	}
+	return c.x, c.y
+}
+
+// GetViewSize returns size of the view in virtual screen coordinates.
+func (c *Camera) GetViewSize() (w, h float32) {
+	if c.viewport == nil {
+		return screensize.width, screensize.height
+	}
+	return c.viewport.w, c.viewport.h
+}
+
+// SetZoom sets zoom factor. 2 shows the world twice as large.
+func (c *Camera) SetZoom(zoom float32) {
+	c.zoom = zoom - 1
+}
+
+// GetZoom returns zoom factor.
+func (c *Camera) GetZoom() float32 {
+	return c.zoom + 1
+}
+
+// SetRotation sets rotation of camera in radian.
+// Positive value rotates the world clockwise on screen.
+func (c *Camera) SetRotation(r float32) {
+	c.r = r
+}
+
+// GetRotation returns rotation of camera.
+func (c *Camera) GetRotation() float32 {
+	return c.r
+}
+
+// SetShakeOffset sets temporary offset of position and rotation for screen shake.
+func (c *Camera) SetShakeOffset(x, y, r float32) {
+	c.shakeX, c.shakeY, c.shakeR = x, y, r
+}
+
+// viewTransform returns an affine that maps world coordinates to
+// virtual screen coordinates. Camera's position is shown at (cx, cy) of screen.
+func (c *Camera) viewTransform(cx, cy float32) f32.Affine {
+	x, y := c.GetPosition()
+	zoom := c.GetZoom()
+	sin, cos := math.Sincos(float64(-(c.r + c.shakeR)))
+	sn, cs := float32(sin)*zoom, float32(cos)*zoom
+	x, y = x+c.shakeX, y+c.shakeY
+	return f32.Affine{
+		{cs, -sn, cx - cs*x + sn*y},
+		{sn, cs, cy - sn*x - cs*y},
+	}
+}
+
+// view returns an affine that maps world coordinates to virtual screen coordinates.
+func (c *Camera) view() f32.Affine {
+	return c.viewTransform(c.viewCenter())
+}
+
+// ScreenToWorld converts specified position in virtual screen coordinates,
+// such as touched position, to world coordinates.
+func (c *Camera) ScreenToWorld(x, y float32) (float32, float32) {
+	m := c.view()
+	m.Inverse(&m)
+	return m[0][0]*x + m[0][1]*y + m[0][2], m[1][0]*x + m[1][1]*y + m[1][2]
+}
+
+// WorldToScreen converts specified position in world coordinates
+// to virtual screen coordinates.
+func (c *Camera) WorldToScreen(x, y float32) (float32, float32) {
+	m := c.view()
+	return m[0][0]*x + m[0][1]*y + m[0][2], m[1][0]*x + m[1][1]*y + m[1][2]
+}
@@ -0,0 +1,75 @@
+package peer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCameraDefault(t *testing.T) {
+	c := &Camera{}
+	x, y := c.WorldToScreen(10, 20)
+	if !nearlyEqual(x, 10) || !nearlyEqual(y, 20) {
+		t.Errorf("unexpected result. [got] (%f, %f) [want] (%f, %f)", x, y, 10.0, 20.0)
+	}
+}
+
+func TestCameraTransform(t *testing.T) {
+	w, h := screensize.width, screensize.height
+	defer func() { screensize.width, screensize.height = w, h }()
+	screensize.width, screensize.height = 200, 100
+
+	c := &Camera{}
+	c.SetPosition(500, 500)
+	c.SetZoom(2)
+
+	// camera position is shown at the center of screen
+	x, y := c.WorldToScreen(500, 500)
+	if !nearlyEqual(x, 100) || !nearlyEqual(y, 50) {
+		t.Errorf("unexpected result. [got] (%f, %f) [want] (%f, %f)", x, y, 100.0, 50.0)
+	}
+	x, y = c.WorldToScreen(510, 500)
+	if !nearlyEqual(x, 120) || !nearlyEqual(y, 50) {
+		t.Errorf("unexpected result. [got] (%f, %f) [want] (%f, %f)", x, y, 120.0, 50.0)
+	}
+
+	// camera rotated counter-clockwise shows the world rotated clockwise
+	c.SetRotation(math.Pi / 2)
+	x, y = c.WorldToScreen(510, 500)
+	if !nearlyEqual(x, 100) || !nearlyEqual(y, 30) {
+		t.Errorf("unexpected result. [got] (%f, %f) [want] (%f, %f)", x, y, 100.0, 30.0)
+	}
+	x, y = c.ScreenToWorld(100, 30)
+	if !nearlyEqual(x, 510) || !nearlyEqual(y, 500) {
+		t.Errorf("unexpected result. [got] (%f, %f) [want] (%f, %f)", x, y, 510.0, 500.0)
+	}
+
+	c.Reset()
+	x, y = c.GetPosition()
+	if x != 100 || y != 50 || c.GetZoom() != 1 || c.GetRotation() != 0 {
+		t.Errorf("camera should be reset")
+	}
+}
+
+func TestScreenSpaceSpriteContains(t *testing.T) {
+	w, h := screensize.width, screensize.height
+	defer func() {
+		screensize.width, screensize.height = w, h
+	}()
+	screensize.width, screensize.height = 200, 100
+
+	world := &Sprite{X: 1000, Y: 50, W: 10, H: 10}
+	hud := &Sprite{X: 100, Y: 50, W: 10, H: 10}
+	hud.SetScreenSpace(true)
+	glpeer := &GLPeer{}
+	glpeer.AppendNode(&ZNode{sprite: world})
+	glpeer.AppendNode(&ZNode{sprite: hud})
+	glpeer.GetCamera().SetPosition(1000, 50)
+
+	// both are shown at the center of screen
+	if !world.Contains(100, 50) {
+		t.Errorf("world sprite should be hit through camera")
+	}
+	if !hud.Contains(100, 50) {
+		t.Errorf("screen-space sprite should be hit")
+	}
+}
@@ -0,0 +1,144 @@
+package peer
+
+import (
+	"github.com/pankona/gomo-simra/simra/simlog"
+	"golang.org/x/mobile/exp/f32"
+)
+
+// clipRect represents a rectangle in virtual screen coordinates.
+// (x, y) is bottom left.
+type clipRect struct {
+	x, y, w, h float32
+}
+
+// intersect returns intersection of two rectangles
+func (r clipRect) intersect(o clipRect) clipRect {
+	x0, y0 := max32(r.x, o.x), max32(r.y, o.y)
+	x1, y1 := min32(r.x+r.w, o.x+o.w), min32(r.y+r.h, o.y+o.h)
+	return clipRect{x0, y0, max32(x1-x0, 0), max32(y1-y0, 0)}
+}
+
+// contains returns true if specified position is inside of the rectangle
+func (r clipRect) contains(x, y float32) bool {
+	return x >= r.x && x < r.x+r.w && y >= r.y && y < r.y+r.h
+}
+
+func min32(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max32(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// SetClipRect clips sprite and its descendants to specified rectangle
+// in virtual screen coordinates. (x, y) is bottom left of the rectangle.
+// Parent sprite can be used as a container that clips its children,
+// such as a scrollable list. Clipped area is not hit by touch or hover.
+func (s *Sprite) SetClipRect(x, y, w, h float32) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+	s.clip = &clipRect{x, y, w, h}
+}
+
+// ClearClipRect removes clip rectangle of sprite
+func (s *Sprite) ClearClipRect() {
+	s.clip = nil
+}
+
+// GetClipRect returns clip rectangle of sprite. ok is false if not clipped.
+func (s *Sprite) GetClipRect() (x, y, w, h float32, ok bool) {
+	if s.clip == nil {
+		return 0, 0, 0, 0, false
+	}
+	return s.clip.x, s.clip.y, s.clip.w, s.clip.h, true
+}
+
+// clipRect returns intersection of clip rectangles of sprite and its ancestors.
+// ok is false if none of them is clipped.
+func (s *Sprite) clipRect() (r clipRect, ok bool) {
+	for p := s; p != nil; p = p.parent {
+		if p.clip == nil {
+			continue
+		}
+		if ok {
+			r = r.intersect(*p.clip)
+		} else {
+			r, ok = *p.clip, true
+		}
+	}
+	return r, ok
+}
+
+// SetMask masks sprite and its descendants by alpha of mask sprite's texture.
+// Only the area where mask's alpha is equal to or greater than threshold
+// is drawn. Mask sprite must be added to the container to have texture,
+// and it can be hidden not to be drawn itself. nil removes the mask.
+func (s *Sprite) SetMask(mask *Sprite, threshold uint8) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+	s.mask = mask
+	s.maskThreshold = threshold
+}
+
+// GetMask returns mask sprite and its threshold. nil if not masked.
+func (s *Sprite) GetMask() (*Sprite, uint8) {
+	return s.mask, s.maskThreshold
+}
+
+// maskInHierarchy returns mask of the nearest sprite from this sprite to its root
+func (s *Sprite) maskInHierarchy() (*Sprite, uint8) {
+	for p := s; p != nil; p = p.parent {
+		if p.mask != nil {
+			return p.mask, p.maskThreshold
+		}
+	}
+	return nil, 0
+}
+
+// isClipped returns true if specified position in virtual screen coordinates
+// is clipped out for the sprite
+func (s *Sprite) isClipped(x, y float32) bool {
+	r, ok := s.clipRect()
+	return ok && !r.contains(x, y)
+}
+
+// nodeOf returns the node that draws specified sprite. nil if not found.
+// The index is built by arrange.
+func (glpeer *GLPeer) nodeOf(s *Sprite) *ZNode {
+	return glpeer.nodeIndex[s]
+}
+
+// maskOf returns mask of the sprite for engine. view maps coordinates of
+// mask sprite's space to the framebuffer. nil if sprite is not masked.
+func (glpeer *GLPeer) maskOf(s *Sprite, view func(mask *Sprite) f32.Affine) *nodeMask {
+	mask, threshold := s.maskInHierarchy()
+	if mask == nil {
+		return nil
+	}
+	zn := glpeer.nodeOf(mask)
+	if zn == nil {
+		return nil
+	}
+	x := zn.Node.EngineFields.SubTex
+	t, ok := x.T.(*texture)
+	if !ok {
+		return nil
+	}
+	v := view(mask)
+	local := mask.transform()
+	nm := &nodeMask{
+		tex:       t,
+		r:         x.R,
+		threshold: float32(threshold) / 255,
+	}
+	nm.m.Mul(&v, &local)
+	zn.applyTrim(&nm.m)
+	return nm
+}
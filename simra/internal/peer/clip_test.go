@@ -0,0 +1,148 @@
+package peer
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"golang.org/x/mobile/exp/f32"
+	"golang.org/x/mobile/exp/sprite"
+	"golang.org/x/mobile/gl"
+)
+
+func TestClipRectInHierarchy(t *testing.T) {
+	parent := &Sprite{X: 50, Y: 50, W: 100, H: 100}
+	child := &Sprite{W: 10, H: 10}
+	if err := parent.AddChild(child); err != nil {
+		t.Fatalf("failed to add child. err: %s", err.Error())
+	}
+
+	if _, ok := child.clipRect(); ok {
+		t.Errorf("sprite should not be clipped by default")
+	}
+
+	parent.SetClipRect(0, 0, 100, 100)
+	child.SetClipRect(50, -10, 100, 30)
+	got, ok := child.clipRect()
+	want := clipRect{50, 0, 50, 20}
+	if !ok || got != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+
+	// child is at (50, 50), which is clipped out by its own clip rectangle
+	if isContained(child, 50, 50) {
+		t.Errorf("clipped area should not be hit")
+	}
+	child.ClearClipRect()
+	if !isContained(child, 50, 50) {
+		t.Errorf("sprite should be hit inside of clip rectangle")
+	}
+}
+
+func TestRenderTargetClipAndMask(t *testing.T) {
+	s := &Sprite{X: 2, Y: 2, W: 4, H: 4}
+	s.SetClipRect(0, 2, 4, 2)
+
+	// mask whose left half is opaque
+	maskImg := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	maskImg.SetRGBA(0, 0, color.RGBA{A: 0xff})
+	maskImg.SetRGBA(0, 1, color.RGBA{A: 0xff})
+	maskTex := &texture{rgba: maskImg, b: maskImg.Bounds(), width: 2, height: 2}
+	mask := &Sprite{X: 2, Y: 2, W: 4, H: 4}
+	mask.SetVisible(false)
+	maskNode := &ZNode{Node: &sprite.Node{}, sprite: mask}
+	maskNode.Node.EngineFields.SubTex = sprite.SubTex{T: maskTex, R: maskImg.Bounds()}
+
+	glpeer := &GLPeer{}
+	glpeer.znodes = ZNodes{newTargetTestNode(s), maskNode}
+	glpeer.layoutDirty = true
+
+	rt, err := glpeer.NewRenderTarget(4, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt.AddSprite(s)
+	glpeer.RenderTo(rt)
+
+	// only top half is drawn by clip rectangle
+	img := rt.Image()
+	if got := img.RGBAAt(1, 1); got != red {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, red)
+	}
+	if got := img.RGBAAt(1, 2); got != (color.RGBA{}) {
+		t.Errorf("unexpected result. [got] %v [want] transparent", got)
+	}
+
+	// only left half is drawn by mask
+	s.ClearClipRect()
+	s.SetMask(mask, 0x80)
+	glpeer.RenderTo(rt)
+	if got := img.RGBAAt(1, 3); got != blue {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, blue)
+	}
+	if got := img.RGBAAt(2, 0); got != (color.RGBA{}) {
+		t.Errorf("unexpected result. [got] %v [want] transparent", got)
+	}
+}
+
+// mockStencilGLContext counts clears of stencil buffer
+type mockStencilGLContext struct {
+	mockGLContext
+	stencilClears int
+}
+
+func (m *mockStencilGLContext) Clear(mask gl.Enum) {
+	if mask&gl.STENCIL_BUFFER_BIT != 0 {
+		m.stencilClears++
+	}
+}
+func (m *mockStencilGLContext) ClearStencil(s int)                           {}
+func (m *mockStencilGLContext) StencilFunc(fn gl.Enum, ref int, mask uint32) {}
+func (m *mockStencilGLContext) StencilOp(fail, zfail, zpass gl.Enum)         {}
+func (m *mockStencilGLContext) ColorMask(red, green, blue, alpha bool)       {}
+
+func TestStencilClearedOncePerMask(t *testing.T) {
+	glctx := &mockStencilGLContext{}
+	e := newBatchTestEngine(glctx)
+	e.stencil = true
+	tex := &texture{width: 4, height: 4}
+	newMask := func(x float32) *nodeMask {
+		return &nodeMask{tex: tex, r: image.Rect(0, 0, 2, 2), m: f32.Affine{{1, 0, x}, {0, 1, 0}}}
+	}
+
+	// siblings of the same mask, an unmasked node between them, then another mask
+	masks := []*nodeMask{newMask(0), newMask(0), nil, newMask(0), newMask(1)}
+	for _, m := range masks {
+		n := newBatchTestNode(e, tex)
+		e.SetMask(n, m)
+		e.Render(n, 0, batchTestSize)
+	}
+	e.flush()
+	if glctx.stencilClears != 2 {
+		t.Errorf("unexpected result. [got] %d [want] %d", glctx.stencilClears, 2)
+	}
+
+	// stencil buffer is drawn again in the next frame
+	e.stencilMask = nil
+	n := newBatchTestNode(e, tex)
+	e.SetMask(n, newMask(1))
+	e.Render(n, 0, batchTestSize)
+	if glctx.stencilClears != 3 {
+		t.Errorf("unexpected result. [got] %d [want] %d", glctx.stencilClears, 3)
+	}
+}
+
+func TestNodeOfUsesIndex(t *testing.T) {
+	s := &Sprite{}
+	zn := &ZNode{Node: &sprite.Node{}, sprite: s}
+	glpeer := &GLPeer{}
+	glpeer.znodes = ZNodes{zn}
+	glpeer.layoutDirty = true
+	glpeer.arrange()
+	if got := glpeer.nodeOf(s); got != zn {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, zn)
+	}
+	if got := glpeer.nodeOf(&Sprite{}); got != nil {
+		t.Errorf("unexpected result. [got] %v [want] nil", got)
+	}
+}
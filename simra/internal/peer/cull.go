@@ -0,0 +1,93 @@
+package peer
+
+import (
+	"golang.org/x/mobile/exp/f32"
+	"golang.org/x/mobile/exp/sprite"
+	"golang.org/x/mobile/exp/sprite/clock"
+)
+
+// RenderStats represents statistics of rendering of the last frame
+type RenderStats struct {
+	// Drawn is the number of sprites drawn to the screen
+	Drawn int
+	// Culled is the number of sprites skipped since they are out of the screen
+	Culled int
+	// DrawCalls is the number of draw calls issued for sprites
+	DrawCalls int
+}
+
+// GetRenderStats returns statistics of rendering of the last frame.
+// Sprites rendered in more than one viewport are counted for each viewport.
+func (glpeer *GLPeer) GetRenderStats() RenderStats {
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+	return glpeer.stats
+}
+
+// bounds represents an axis aligned rectangle in pt of the screen, whose origin is top left
+type bounds struct {
+	minX, minY, maxX, maxY float32
+}
+
+// boundsOf returns bounding box of the parallelogram represented by m,
+// that maps unit square to the screen in pt
+func boundsOf(m *f32.Affine) bounds {
+	b := bounds{m[0][2], m[1][2], m[0][2], m[1][2]}
+	corners := [3][2]float32{
+		{m[0][0], m[1][0]},
+		{m[0][1], m[1][1]},
+		{m[0][0] + m[0][1], m[1][0] + m[1][1]},
+	}
+	for _, c := range corners {
+		x, y := m[0][2]+c[0], m[1][2]+c[1]
+		if x < b.minX {
+			b.minX = x
+		} else if x > b.maxX {
+			b.maxX = x
+		}
+		if y < b.minY {
+			b.minY = y
+		} else if y > b.maxY {
+			b.maxY = y
+		}
+	}
+	return b
+}
+
+// circleBounds returns bounding box of the sprite's bounding circle seen through view
+func circleBounds(s *Sprite, view *f32.Affine) bounds {
+	x, y, r := s.boundingCircle()
+	cx := view[0][0]*x + view[0][1]*y + view[0][2]
+	cy := view[1][0]*x + view[1][1]*y + view[1][2]
+	r *= scaleBound(view)
+	return bounds{cx - r, cy - r, cx + r, cy + r}
+}
+
+// overlaps returns true if the rectangles share any area
+func (b bounds) overlaps(o bounds) bool {
+	return b.minX < o.maxX && o.minX < b.maxX && b.minY < o.maxY && o.minY < b.maxY
+}
+
+// visibleBounds returns area of the screen in pt where sprites are drawn,
+// including letterbox margins. If clipped, the area is limited to clip
+// rectangle in virtual screen coordinates.
+func visibleBounds(screen *f32.Affine, clip clipRect, clipped bool) bounds {
+	if !clipped {
+		return bounds{0, 0, float32(screensize.sz.WidthPt), float32(screensize.sz.HeightPt)}
+	}
+	// screen transform flips y axis
+	return bounds{
+		minX: screen[0][0]*clip.x + screen[0][2],
+		minY: screen[1][1]*(clip.y+clip.h) + screen[1][2],
+		maxX: screen[0][0]*(clip.x+clip.w) + screen[0][2],
+		maxY: screen[1][1]*clip.y + screen[1][2],
+	}
+}
+
+// Arrange calls arranger of the node without drawing it.
+// This is used for the nodes that are culled.
+func (e *engine) Arrange(n *sprite.Node, t clock.Time) {
+	if n.Arranger != nil && !e.skipArrange {
+		n.Arranger.Arrange(e, n, t)
+	}
+}
@@ -0,0 +1,274 @@
+package peer
+
+import (
+	"image"
+	"math"
+	"testing"
+
+	"golang.org/x/mobile/app"
+	"golang.org/x/mobile/event/size"
+	"golang.org/x/mobile/exp/f32"
+	"golang.org/x/mobile/exp/sprite"
+	"golang.org/x/mobile/geom"
+	"golang.org/x/mobile/gl"
+)
+
+func (m *mockGLContext) ClearColor(red, green, blue, alpha float32) {}
+func (m *mockGLContext) Clear(mask gl.Enum)                         {}
+
+// useTestScreen configures screen of w x h pt that shows virtual screen of vw x vh.
+// returned function restores the screen.
+func useTestScreen(w, h int, vw, vh float32) func() {
+	saved := *screensize
+	screensize.SetScreenSize(size.Event{
+		WidthPx:     w,
+		HeightPx:    h,
+		WidthPt:     geom.Pt(w),
+		HeightPt:    geom.Pt(h),
+		PixelsPerPt: 1,
+	})
+	screensize.SetDesiredScreenSize(vw, vh)
+	return func() { *screensize = saved }
+}
+
+func TestBoundsOf(t *testing.T) {
+	// unit square rotated by 45 degrees around the origin
+	c := float32(math.Sqrt(0.5))
+	m := f32.Affine{
+		{c, -c, 10},
+		{c, c, 10},
+	}
+	got := boundsOf(&m)
+	want := bounds{10 - c, 10, 10 + c, 10 + 2*c}
+	if !nearlyEqual(got.minX, want.minX) || !nearlyEqual(got.minY, want.minY) ||
+		!nearlyEqual(got.maxX, want.maxX) || !nearlyEqual(got.maxY, want.maxY) {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+}
+
+func TestVisibleBounds(t *testing.T) {
+	// virtual screen is placed at the center with margins of 50 pt
+	defer useTestScreen(200, 100, 100, 100)()
+	screen := screensize.screenTransform()
+
+	got := visibleBounds(&screen, clipRect{}, false)
+	want := bounds{0, 0, 200, 100}
+	if got != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+
+	// bottom left quarter of virtual screen
+	got = visibleBounds(&screen, clipRect{0, 0, 50, 50}, true)
+	want = bounds{50, 50, 100, 100}
+	if got != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+}
+
+// newCullTestPeer returns GLPeer and SpriteContainer that draw specified sprites
+func newCullTestPeer(sprites ...*Sprite) (*GLPeer, *SpriteContainer) {
+	glpeer := &GLPeer{
+		glc: &GLContext{
+			glcontext: &mockGLContext{},
+			publish:   func() app.PublishResult { return app.PublishResult{} },
+		},
+		eng: newBatchTestEngine(&mockGLContext{}),
+	}
+	sc := &SpriteContainer{gl: glpeer}
+	tex := &texture{width: 2, height: 2}
+	for _, s := range sprites {
+		_ = sc.AddSprite(s, &sprite.SubTex{T: tex, R: image.Rect(0, 0, 2, 2)}, nil)
+	}
+	return glpeer, sc
+}
+
+func TestCulling(t *testing.T) {
+	defer useTestScreen(200, 100, 100, 100)()
+
+	onScreen := &Sprite{X: 50, Y: 50, W: 10, H: 10}
+	// letterbox margins are also visible
+	inMargin := &Sprite{X: -20, Y: 50, W: 10, H: 10}
+	offScreen := &Sprite{X: 300, Y: 50, W: 10, H: 10}
+	// rotated sprite whose corner reaches the screen
+	rotated := &Sprite{X: 156, Y: 50, W: 10, H: 10, R: math.Pi / 4}
+	glpeer, sc := newCullTestPeer(onScreen, inMargin, offScreen, rotated)
+
+	tcs := []struct {
+		s      *Sprite
+		culled bool
+	}{
+		{s: onScreen, culled: false},
+		{s: inMargin, culled: false},
+		{s: offScreen, culled: true},
+		{s: rotated, culled: false},
+	}
+	glpeer.apply(sc, glpeer.getScene().defaultViewport, 0)
+	for i, tc := range tcs {
+		if got := sc.index[tc.s].znode.culled; got != tc.culled {
+			t.Errorf("[%d] unexpected result. [got] %v [want] %v", i, got, tc.culled)
+		}
+	}
+
+	// camera shows the sprite that was off screen
+	glpeer.GetCamera().SetPosition(300, 50)
+	glpeer.apply(sc, glpeer.getScene().defaultViewport, 0)
+	if !sc.index[onScreen].znode.culled {
+		t.Errorf("sprite moved out by camera should be culled")
+	}
+	if sc.index[offScreen].znode.culled {
+		t.Errorf("sprite moved in by camera should not be culled")
+	}
+}
+
+func TestCullingClip(t *testing.T) {
+	defer useTestScreen(100, 100, 100, 100)()
+
+	s := &Sprite{X: 80, Y: 80, W: 10, H: 10}
+	s.SetClipRect(0, 0, 50, 50)
+	glpeer, sc := newCullTestPeer(s)
+	glpeer.apply(sc, glpeer.getScene().defaultViewport, 0)
+	if !sc.index[s].znode.culled {
+		t.Errorf("sprite out of its clip rectangle should be culled")
+	}
+
+	s.SetClipRect(0, 0, 100, 100)
+	glpeer.apply(sc, glpeer.getScene().defaultViewport, 0)
+	if sc.index[s].znode.culled {
+		t.Errorf("sprite in its clip rectangle should not be culled")
+	}
+}
+
+func TestRenderStats(t *testing.T) {
+	defer useTestScreen(100, 100, 100, 100)()
+
+	arranged := 0
+	culled := &Sprite{X: 300, Y: 50, W: 10, H: 10}
+	glpeer, sc := newCullTestPeer(&Sprite{X: 10, Y: 50, W: 10, H: 10}, &Sprite{X: 90, Y: 50, W: 10, H: 10})
+	_ = sc.AddSprite(culled, &sprite.SubTex{T: &texture{width: 2, height: 2}}, func() {
+		arranged++
+	})
+	glpeer.Update(sc)
+
+	want := RenderStats{Drawn: 2, Culled: 1, DrawCalls: 1}
+	if got := glpeer.GetRenderStats(); got != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+	// arranger of culled sprite is still called
+	if arranged != 1 {
+		t.Errorf("unexpected result. [got] %d [want] %d", arranged, 1)
+	}
+}
+
+func BenchmarkUpdateCulling(b *testing.B) {
+	defer useTestScreen(1000, 1000, 1000, 1000)()
+	update := func(b *testing.B, scrolled bool) {
+		glpeer, sc := newBenchmarkPeer(b, 2000, true)
+		if scrolled {
+			glpeer.GetCamera().SetPosition(5000, 5000)
+		}
+		glpeer.glc = &GLContext{
+			glcontext: &mockGLContext{},
+			publish:   func() app.PublishResult { return app.PublishResult{} },
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			glpeer.Update(sc)
+		}
+		stats := glpeer.GetRenderStats()
+		b.ReportMetric(float64(stats.Drawn), "drawn/frame")
+		b.ReportMetric(float64(stats.Culled), "culled/frame")
+	}
+	b.Run("on screen", func(b *testing.B) {
+		update(b, false)
+	})
+	b.Run("scrolled away", func(b *testing.B) {
+		update(b, true)
+	})
+}
+
+func TestCullingSkipsTransform(t *testing.T) {
+	defer useTestScreen(100, 100, 100, 100)()
+
+	offScreen := &Sprite{X: 300, Y: 50, W: 10, H: 10}
+	glpeer, sc := newCullTestPeer(offScreen)
+	c := &sc.index[offScreen].znode.transform
+	for i := 0; i < 3; i++ {
+		offScreen.X++
+		glpeer.apply(sc, glpeer.getScene().defaultViewport, 0)
+		if !sc.index[offScreen].znode.culled {
+			t.Errorf("[%d] sprite out of the screen should be culled", i)
+		}
+		if c.localValid || c.at(0, &f32.Affine{}) != nil || c.applied != 0 {
+			t.Errorf("[%d] transform of culled sprite should not be computed", i)
+		}
+	}
+
+	offScreen.X = 50
+	glpeer.apply(sc, glpeer.getScene().defaultViewport, 0)
+	if sc.index[offScreen].znode.culled || !c.localValid || c.applied != 1 {
+		t.Errorf("transform of sprite moved into the screen should be computed")
+	}
+}
+
+func TestBoundingCircle(t *testing.T) {
+	parent := &Sprite{X: 30, Y: -20, R: 0.5, skewX: 0.3}
+	parent.SetScaleFactor(2, -1)
+	tcs := []*Sprite{
+		{X: 10, Y: 20, W: 30, H: 40},
+		{X: 10, Y: 20, W: 30, H: 40, R: 1, pivotX: 0.5, pivotY: -0.5},
+		{X: -5, W: 8, H: 2, skewX: 0.7, skewY: -0.2, flipX: true},
+	}
+	tcs[1].SetScaleFactor(3, 0.5)
+	view := f32.Affine{
+		{2, 0.5, 7},
+		{-0.5, 2, 3},
+	}
+	for i, s := range tcs {
+		for _, p := range []*Sprite{nil, parent} {
+			if p != nil {
+				if err := p.AddChild(s); err != nil {
+					t.Fatal(err)
+				}
+			}
+			local := s.transform()
+			var affine f32.Affine
+			affine.Mul(&view, &local)
+			got, want := circleBounds(s, &view), boundsOf(&affine)
+			if want.minX < got.minX || want.minY < got.minY || want.maxX > got.maxX || want.maxY > got.maxY {
+				t.Errorf("[%d] bounding circle doesn't contain the sprite. [got] %v [want] %v", i, got, want)
+			}
+		}
+	}
+}
+
+func TestTransformCachedPerViewport(t *testing.T) {
+	defer useTestScreen(100, 100, 100, 100)()
+
+	s := &Sprite{X: 50, Y: 50, W: 10, H: 10}
+	glpeer, sc := newCullTestPeer(s)
+	left := NewViewport(0, 0, 50, 100)
+	right := NewViewport(50, 0, 50, 100)
+	left.GetCamera().SetPosition(50, 50)
+	right.GetCamera().SetPosition(50, 50)
+	glpeer.AddViewport(left)
+	glpeer.AddViewport(right)
+
+	c := &sc.index[s].znode.transform
+	glpeer.apply(sc, left, 0)
+	glpeer.apply(sc, right, 1)
+	v0, v1 := c.views[0], c.views[1]
+	if !v0.valid || !v1.valid || v0.affine == v1.affine {
+		t.Fatalf("transforms should be cached for each viewport. [got] %v, %v", v0, v1)
+	}
+
+	// the next frame uses cached transforms
+	c.localValid = false
+	glpeer.apply(sc, left, 0)
+	glpeer.apply(sc, right, 1)
+	if c.localValid || c.views[0] != v0 || c.views[1] != v1 {
+		t.Errorf("transforms of unchanged sprite should not be computed again")
+	}
+	if c.applied != 2 {
+		t.Errorf("unexpected result. [got] %d [want] %d", c.applied, 2)
+	}
+}
@@ -0,0 +1,230 @@
+package peer
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"math/rand"
+)
+
+// built-in effects. Each of them has CPU reference implementation
+// that is used for rendering without GPU.
+
+const grayscaleSource = `
+uniform float amount;
+vec4 effect(sampler2D tex, vec2 uv) {
+	vec4 c = texture2D(tex, uv);
+	float gray = dot(c.rgb, vec3(0.299, 0.587, 0.114));
+	return vec4(mix(c.rgb, vec3(gray), amount), c.a);
+}
+`
+
+// NewGrayscale returns a material that desaturates sprite, such as disabled button.
+// Float uniform "amount" is the ratio of desaturation in 0..1.
+func NewGrayscale(amount float32) *Material {
+	m := NewMaterial(grayscaleSource)
+	m.SetFloat("amount", amount)
+	m.reference = grayscale
+	return m
+}
+
+func grayscale(m *Material, s sampler, u, v float32) [4]float32 {
+	c := s.at(u, v)
+	amount := m.GetFloat("amount")
+	gray := c[0]*0.299 + c[1]*0.587 + c[2]*0.114
+	for i := 0; i < 3; i++ {
+		c[i] = mix(c[i], gray, amount)
+	}
+	return c
+}
+
+const outlineSource = `
+uniform vec4 outlineColor;
+vec4 effect(sampler2D tex, vec2 uv) {
+	vec4 c = sampleRect(tex, uv);
+	if (c.a >= 0.5) {
+		return c;
+	}
+	float a = max(
+		max(sampleRect(tex, uv + vec2(texelSize.x, 0.0)).a, sampleRect(tex, uv - vec2(texelSize.x, 0.0)).a),
+		max(sampleRect(tex, uv + vec2(0.0, texelSize.y)).a, sampleRect(tex, uv - vec2(0.0, texelSize.y)).a));
+	if (a >= 0.5) {
+		return outlineColor;
+	}
+	return c;
+}
+`
+
+// NewOutline returns a material that draws 1 texel outline around opaque area of sprite.
+// Since outline is drawn inside of sprite, texture needs transparent margin.
+// Color uniform "outlineColor" is color of the outline.
+func NewOutline(c color.RGBA) *Material {
+	m := NewMaterial(outlineSource)
+	m.SetColor("outlineColor", c)
+	m.reference = outline
+	return m
+}
+
+func outline(m *Material, s sampler, u, v float32) [4]float32 {
+	c := s.at(u, v)
+	if c[3] >= 0.5 {
+		return c
+	}
+	du, dv := s.texel()
+	a := float32(math.Max(
+		math.Max(float64(s.at(u+du, v)[3]), float64(s.at(u-du, v)[3])),
+		math.Max(float64(s.at(u, v+dv)[3]), float64(s.at(u, v-dv)[3]))))
+	if a >= 0.5 {
+		return m.vec4("outlineColor")
+	}
+	return c
+}
+
+const dissolveSource = `
+uniform float progress;
+uniform float edgeWidth;
+uniform vec4 edgeColor;
+uniform sampler2D noise;
+uniform vec2 noiseOffset;
+vec4 effect(sampler2D tex, vec2 uv) {
+	vec4 c = texture2D(tex, uv);
+	float n = texture2D(noise, fract(toUnit(uv) + noiseOffset)).r;
+	if (n < progress) {
+		discard;
+	}
+	if (n < progress + edgeWidth) {
+		return edgeColor * c.a;
+	}
+	return c;
+}
+`
+
+// noiseSize is size of noise image for dissolve effect
+const noiseSize = 64
+
+// noiseImage is the noise image shared by all dissolve effects,
+// so that they are drawn by the same texture
+var noiseImage = newNoiseImage(noiseSize, noiseSize, 1)
+
+// NewDissolve returns a material that dissolves sprite by noise.
+// Float uniform "progress" is the ratio of dissolved area in 0..1, and
+// texels near the border of dissolved area are drawn in edge color.
+// Uniforms "edgeWidth" and "edgeColor" configures the edge,
+// Sampler "noise" is a grayscale noise image shared by dissolve effects,
+// and vec2 uniform "noiseOffset" shifts the noise by seed.
+func NewDissolve(progress float32, edge color.RGBA, seed int64) *Material {
+	m := NewMaterial(dissolveSource)
+	m.SetFloat("progress", progress)
+	m.SetFloat("edgeWidth", 0.05)
+	m.SetColor("edgeColor", edge)
+	m.SetImage("noise", noiseImage)
+	r := rand.New(rand.NewSource(seed))
+	m.SetVec2("noiseOffset", r.Float32(), r.Float32())
+	m.reference = dissolve
+	return m
+}
+
+func newNoiseImage(w, h int, seed int64) *image.Gray {
+	r := rand.New(rand.NewSource(seed))
+	img := image.NewGray(image.Rect(0, 0, w, h))
+	r.Read(img.Pix)
+	return img
+}
+
+func dissolve(m *Material, s sampler, u, v float32) [4]float32 {
+	c := s.at(u, v)
+	offset, _ := m.uniforms["noiseOffset"].([2]float32)
+	nu, nv := u+offset[0], v+offset[1]
+	nu, nv = nu-float32(math.Floor(float64(nu))), nv-float32(math.Floor(float64(nv)))
+	n := sampleImage(m.images["noise"], nu, nv)[0]
+	progress := m.GetFloat("progress")
+	if n < progress {
+		// transparent color doesn't change the background in all blend modes
+		return [4]float32{}
+	}
+	if n < progress+m.GetFloat("edgeWidth") {
+		e := m.vec4("edgeColor")
+		for i := range e {
+			e[i] *= c[3]
+		}
+		return e
+	}
+	return c
+}
+
+const paletteSwapSource = `
+uniform sampler2D palette;
+uniform float paletteSize;
+uniform float paletteWidth;
+vec4 effect(sampler2D tex, vec2 uv) {
+	vec4 c = texture2D(tex, uv);
+	if (c.a <= 0.0) {
+		return c;
+	}
+	float index = floor(c.r / c.a * (paletteSize - 1.0) + 0.5);
+	return texture2D(palette, vec2((index + 0.5) / paletteWidth, 0.5)) * c.a;
+}
+`
+
+// NewPaletteSwap returns a material that replaces colors of sprite by the palette.
+// Red channel of texture is used as index of the palette, that is,
+// 0 is the first color and 255 is the last color of the palette.
+func NewPaletteSwap(palette []color.RGBA) *Material {
+	m := NewMaterial(paletteSwapSource)
+	img := image.NewRGBA(image.Rect(0, 0, roundToPower2(len(palette)), 1))
+	for i, c := range palette {
+		img.SetRGBA(i, 0, c)
+	}
+	m.SetImage("palette", img)
+	m.SetFloat("paletteSize", float32(len(palette)))
+	m.SetFloat("paletteWidth", float32(img.Bounds().Dx()))
+	m.reference = paletteSwap
+	return m
+}
+
+func paletteSwap(m *Material, s sampler, u, v float32) [4]float32 {
+	c := s.at(u, v)
+	if c[3] <= 0 {
+		return c
+	}
+	index := float32(math.Floor(float64(c[0]/c[3]*(m.GetFloat("paletteSize")-1) + 0.5)))
+	p := sampleImage(m.images["palette"], (index+0.5)/m.GetFloat("paletteWidth"), 0.5)
+	for i := range p {
+		p[i] *= c[3]
+	}
+	return p
+}
+
+const hitFlashSource = `
+uniform vec4 flashColor;
+uniform float amount;
+vec4 effect(sampler2D tex, vec2 uv) {
+	vec4 c = texture2D(tex, uv);
+	return vec4(mix(c.rgb, flashColor.rgb * c.a, amount), c.a);
+}
+`
+
+// NewHitFlash returns a material that fills opaque area of sprite with flash color,
+// such as a character taking damage. Float uniform "amount" is the ratio of
+// flash color in 0..1, and color uniform "flashColor" is the color.
+func NewHitFlash(c color.RGBA, amount float32) *Material {
+	m := NewMaterial(hitFlashSource)
+	m.SetColor("flashColor", c)
+	m.SetFloat("amount", amount)
+	m.reference = hitFlash
+	return m
+}
+
+func hitFlash(m *Material, s sampler, u, v float32) [4]float32 {
+	c := s.at(u, v)
+	f := m.vec4("flashColor")
+	amount := m.GetFloat("amount")
+	for i := 0; i < 3; i++ {
+		c[i] = mix(c[i], f[i]*c[3], amount)
+	}
+	return c
+}
+
+func mix(x, y, a float32) float32 {
+	return x*(1-a) + y*a
+}
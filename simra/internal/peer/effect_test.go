@@ -0,0 +1,191 @@
+package peer
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"golang.org/x/mobile/gl"
+)
+
+// newEffectTestSampler returns a sampler of 3x3 texture whose center is opaque
+func newEffectTestSampler(center color.RGBA) sampler {
+	img := image.NewRGBA(image.Rect(0, 0, 3, 3))
+	img.SetRGBA(1, 1, center)
+	return sampler{
+		t: &texture{rgba: img, b: img.Bounds(), width: 3, height: 3},
+		r: img.Bounds(),
+	}
+}
+
+func nearlyEqualColor(a, b [4]float32) bool {
+	for i := range a {
+		if !nearlyEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestGrayscale(t *testing.T) {
+	s := newEffectTestSampler(color.RGBA{R: 0xff, A: 0xff})
+	m := NewGrayscale(1)
+	got := m.reference(m, s, 0.5, 0.5)
+	want := [4]float32{0.299, 0.299, 0.299, 1}
+	if !nearlyEqualColor(got, want) {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+
+	m.SetFloat("amount", 0)
+	got = m.reference(m, s, 0.5, 0.5)
+	want = [4]float32{1, 0, 0, 1}
+	if !nearlyEqualColor(got, want) {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+}
+
+func TestOutline(t *testing.T) {
+	s := newEffectTestSampler(color.RGBA{R: 0xff, A: 0xff})
+	m := NewOutline(color.RGBA{G: 0xff, A: 0xff})
+	tcs := []struct {
+		u, v float32
+		want [4]float32
+	}{
+		// opaque texel is kept
+		{u: 0.5, v: 0.5, want: [4]float32{1, 0, 0, 1}},
+		// transparent texels next to opaque one are outline
+		{u: 0.5, v: 0.1, want: [4]float32{0, 1, 0, 1}},
+		{u: 0.1, v: 0.5, want: [4]float32{0, 1, 0, 1}},
+		// diagonal texel is not outline
+		{u: 0.1, v: 0.1, want: [4]float32{}},
+	}
+	for i, tc := range tcs {
+		got := m.reference(m, s, tc.u, tc.v)
+		if !nearlyEqualColor(got, tc.want) {
+			t.Errorf("[%d] unexpected result. [got] %v [want] %v", i, got, tc.want)
+		}
+	}
+}
+
+func TestDissolve(t *testing.T) {
+	s := newEffectTestSampler(color.RGBA{R: 0xff, A: 0xff})
+	m := NewDissolve(0, color.RGBA{B: 0xff, A: 0xff}, 1)
+	m.SetFloat("edgeWidth", 0)
+	if got := m.reference(m, s, 0.5, 0.5); got != [4]float32{1, 0, 0, 1} {
+		t.Errorf("nothing should be dissolved at progress 0. [got] %v", got)
+	}
+
+	m.SetFloat("progress", 1)
+	if got := m.reference(m, s, 0.5, 0.5); got != [4]float32{} {
+		t.Errorf("everything should be dissolved at progress 1. [got] %v", got)
+	}
+
+	// texels are dissolved or drawn in edge color by noise
+	m.SetFloat("progress", 0.5)
+	m.SetFloat("edgeWidth", 0.5)
+	got := m.reference(m, s, 0.5, 0.5)
+	if got != [4]float32{} && got != [4]float32{0, 0, 1, 1} {
+		t.Errorf("unexpected result. [got] %v", got)
+	}
+
+	// dissolves share the noise image, which is shifted by seed
+	other := NewDissolve(0, color.RGBA{}, 2)
+	if m.images["noise"] != other.images["noise"] {
+		t.Errorf("noise image should be shared")
+	}
+	if m.uniforms["noiseOffset"] == other.uniforms["noiseOffset"] {
+		t.Errorf("noise should be shifted by seed. [got] %v", other.uniforms["noiseOffset"])
+	}
+}
+
+func TestPaletteSwap(t *testing.T) {
+	palette := []color.RGBA{
+		{R: 0xff, A: 0xff},
+		{G: 0xff, A: 0xff},
+		{B: 0xff, A: 0xff},
+	}
+	m := NewPaletteSwap(palette)
+	tcs := []struct {
+		index uint8
+		want  [4]float32
+	}{
+		{index: 0, want: [4]float32{1, 0, 0, 1}},
+		{index: 0x80, want: [4]float32{0, 1, 0, 1}},
+		{index: 0xff, want: [4]float32{0, 0, 1, 1}},
+	}
+	for i, tc := range tcs {
+		s := newEffectTestSampler(color.RGBA{R: tc.index, A: 0xff})
+		got := m.reference(m, s, 0.5, 0.5)
+		if got != tc.want {
+			t.Errorf("[%d] unexpected result. [got] %v [want] %v", i, got, tc.want)
+		}
+	}
+}
+
+func TestHitFlash(t *testing.T) {
+	s := newEffectTestSampler(color.RGBA{R: 0x80, A: 0x80})
+	m := NewHitFlash(color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, 1)
+	got := m.reference(m, s, 0.5, 0.5)
+	a := float32(0x80) / 255
+	want := [4]float32{a, a, a, a}
+	if !nearlyEqualColor(got, want) {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+}
+
+func TestRenderTargetMaterial(t *testing.T) {
+	s := &Sprite{X: 2, Y: 2, W: 4, H: 4}
+	s.SetMaterial(NewGrayscale(1))
+
+	glpeer := &GLPeer{}
+	glpeer.znodes = ZNodes{newTargetTestNode(s)}
+	glpeer.layoutDirty = true
+
+	rt, err := glpeer.NewRenderTarget(4, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt.AddSprite(s)
+	glpeer.RenderTo(rt)
+
+	// red is converted to gray
+	want := color.RGBA{R: 0x4c, G: 0x4c, B: 0x4c, A: 0xff}
+	if got := rt.Image().RGBAAt(0, 0); got != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+}
+
+// mockSamplerGLContext accepts calls to bind samplers of materials
+type mockSamplerGLContext struct {
+	*mockObjectGLContext
+}
+
+func (m *mockSamplerGLContext) ActiveTexture(texture gl.Enum)   {}
+func (m *mockSamplerGLContext) Uniform1i(dst gl.Uniform, v int) {}
+
+func TestMaterialImageLoadFailure(t *testing.T) {
+	glctx := &mockSamplerGLContext{newMockObjectGLContext()}
+	e, err := newEngine(glctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewMaterial("")
+	m.SetImage("pattern", image.NewRGBA(image.Rect(0, 0, 2, 2)))
+	e.current = e.def
+
+	glctx.noTexture = true
+	e.bindMaterial(m)
+	if len(e.images) != 0 {
+		t.Errorf("failed texture should not be cached. [got] %d", len(e.images))
+	}
+	if glctx.bound != 0 {
+		t.Errorf("unexpected result. [got] %d [want] %d", glctx.bound, 0)
+	}
+
+	// loaded at the next use
+	glctx.noTexture = false
+	e.bindMaterial(m)
+	if len(e.images) != 1 || glctx.bound == 0 {
+		t.Errorf("texture should be loaded. [got] %d textures, bound %d", len(e.images), glctx.bound)
+	}
+}
@@ -0,0 +1,817 @@
+package peer
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/pankona/gomo-simra/simra/simlog"
+	"golang.org/x/mobile/event/size"
+	"golang.org/x/mobile/exp/f32"
+	"golang.org/x/mobile/exp/sprite"
+	"golang.org/x/mobile/exp/sprite/clock"
+	"golang.org/x/mobile/gl"
+)
+
+// BlendMode represents how a sprite is blended with the pixels behind it.
+type BlendMode int
+
+const (
+	// BlendNormal is ordinary alpha blending
+	BlendNormal BlendMode = iota
+	// BlendAdditive adds sprite's color to the background. useful for glows.
+	BlendAdditive
+	// BlendMultiply multiplies sprite's color with the background. useful for shadows.
+	BlendMultiply
+	// BlendScreen is inverse of multiply. brightens the background.
+	BlendScreen
+)
+
+// blendFunc returns source and destination factors of glBlendFunc for the mode.
+// Since textures have premultiplied alpha, factors are for premultiplied colors.
+func (m BlendMode) blendFunc() (src, dst gl.Enum) {
+	switch m {
+	case BlendAdditive:
+		return gl.ONE, gl.ONE
+	case BlendMultiply:
+		return gl.DST_COLOR, gl.ONE_MINUS_SRC_ALPHA
+	case BlendScreen:
+		return gl.ONE, gl.ONE_MINUS_SRC_COLOR
+	default:
+		return gl.ONE, gl.ONE_MINUS_SRC_ALPHA
+	}
+}
+
+// premultipliedTint returns color factor that is multiplied with texture color
+// in the shader. Since texture color is premultiplied, the factor is also premultiplied.
+func premultipliedTint(tint color.RGBA, alpha float32) [4]float32 {
+	a := alpha * float32(tint.A) / 255
+	if a < 0 {
+		a = 0
+	} else if a > 1 {
+		a = 1
+	}
+	return [4]float32{
+		float32(tint.R) / 255 * a,
+		float32(tint.G) / 255 * a,
+		float32(tint.B) / 255 * a,
+		a,
+	}
+}
+
+// nodeState represents rendering state of a sprite.Node held by engine
+type nodeState struct {
+	relTransform f32.Affine
+	tint         [4]float32
+	blend        BlendMode
+	// scissor is scissor box in pixels of framebuffer for glScissor.
+	// it is used only if clipped is true.
+	scissor [4]int32
+	clipped bool
+	// mask masks drawing of the node. nil if not masked.
+	mask *nodeMask
+	// material draws the node. nil means the default drawing.
+	material *Material
+	// pieces are drawn instead of whole sub texture. nil if not divided.
+	pieces []slicePiece
+}
+
+// nodeMask represents a texture whose alpha masks drawing of a node
+type nodeMask struct {
+	tex *texture
+	r   image.Rectangle
+	// m maps the unit square to the screen in pt, as absolute transform of node
+	m f32.Affine
+	// threshold is the minimum alpha of mask to draw, in 0..1
+	threshold float32
+}
+
+// engine is an implementation of sprite.Engine.
+// In addition to glsprite, this engine supports alpha, color tint,
+// blend mode, clipping and masking per node.
+// Consecutive nodes that share a texture are drawn in a single draw call.
+type engine struct {
+	glctx    gl.Context
+	quadXY   gl.Buffer
+	quadUV   gl.Buffer
+	textures map[*texture]struct{}
+	nodes    []*nodeState
+	// def is the program used for sprites that have no material
+	def *shaderProgram
+	// programs are compiled programs of materials by their source, shared by
+	// materials of the same source. nil if compilation of the source failed.
+	programs map[string]*shaderProgram
+	// images are textures of images bound to materials
+	images map[image.Image]*texture
+	// current is the program in use. nil while drawing batches.
+	current *shaderProgram
+	// batch is quads waiting to be drawn by batchProgram
+	batch         batch
+	batchProgram  *batchProgram
+	batchVertices gl.Buffer
+	batchIndices  gl.Buffer
+	// drawCalls is the number of draw calls issued for sprites in current frame
+	drawCalls int
+	// drawn is the number of nodes drawn in current frame
+	drawn int
+
+	absTransforms []f32.Affine
+	// skipArrange is true if arrangers of nodes should not be called.
+	// This is used to render the same nodes more than once in a frame.
+	skipArrange bool
+	// flipY is true if y axis of clip space should be flipped.
+	// This is used to render into a texture, whose row 0 is top of image.
+	flipY bool
+	// stencil is true if current framebuffer has stencil buffer.
+	// masks are drawn into stencil buffer if true, otherwise
+	// they are sampled in the shader.
+	stencil bool
+	// screenStencil is true if the screen has stencil buffer
+	screenStencil bool
+	// stencilMask is the mask drawn in stencil buffer of current framebuffer.
+	// nil if stencil buffer should be drawn again.
+	stencilMask *nodeMask
+}
+
+var _ sprite.Engine = (*engine)(nil)
+
+func newEngine(glctx gl.Context) (*engine, error) {
+	e := &engine{
+		programs: make(map[string]*shaderProgram),
+		images:   make(map[image.Image]*texture),
+		textures: make(map[*texture]struct{}),
+		// index 0 is reserved for unregistered node
+		nodes: []*nodeState{nil},
+	}
+	if err := e.restore(glctx); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// restore creates GL objects of engine with specified GL context.
+// Textures held by engine are uploaded again from their sources.
+func (e *engine) restore(glctx gl.Context) error {
+	def, err := newShaderProgram(glctx, defaultEffect)
+	if err != nil {
+		return err
+	}
+	bp, err := newBatchProgram(glctx)
+	if err != nil {
+		glctx.DeleteProgram(def.program)
+		return err
+	}
+	e.glctx = glctx
+	e.def = def
+	e.batchProgram = bp
+	e.screenStencil = glctx.GetInteger(gl.STENCIL_BITS) > 0
+	e.stencil = e.screenStencil
+	e.quadXY = glctx.CreateBuffer()
+	e.quadUV = glctx.CreateBuffer()
+	glctx.BindBuffer(gl.ARRAY_BUFFER, e.quadXY)
+	glctx.BufferData(gl.ARRAY_BUFFER, quadXYCoords, gl.STATIC_DRAW)
+	glctx.BindBuffer(gl.ARRAY_BUFFER, e.quadUV)
+	glctx.BufferData(gl.ARRAY_BUFFER, quadUVCoords, gl.STATIC_DRAW)
+	e.batchVertices = glctx.CreateBuffer()
+	e.batchIndices = glctx.CreateBuffer()
+	glctx.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, e.batchIndices)
+	glctx.BufferData(gl.ELEMENT_ARRAY_BUFFER, batchIndices(), gl.STATIC_DRAW)
+	for t := range e.textures {
+		pix := t.rgba
+		if pix == nil {
+			pix, err = t.load()
+			if err != nil {
+				// the texture is drawn transparent
+				simlog.Errorf("failed to restore texture %s: %v", t.source, err)
+				pix = image.NewRGBA(image.Rect(0, 0, t.width, t.height))
+			}
+		}
+		if err := t.create(pix); err != nil {
+			simlog.Errorf("failed to restore texture %s: %v", t.source, err)
+		}
+	}
+	return nil
+}
+
+// Register registers a node to engine
+func (e *engine) Register(n *sprite.Node) {
+	if n.EngineFields.Index != 0 {
+		panic("engine: sprite.Node already registered")
+	}
+	ns := &nodeState{
+		tint: [4]float32{1, 1, 1, 1},
+	}
+	ns.relTransform.Identity()
+	e.nodes = append(e.nodes, ns)
+	n.EngineFields.Index = int32(len(e.nodes) - 1)
+}
+
+// Unregister unregisters a node from engine
+func (e *engine) Unregister(n *sprite.Node) {
+	e.nodes[n.EngineFields.Index] = nil
+	n.EngineFields.Index = 0
+}
+
+// LoadTexture loads specified image as a texture.
+// The image is referred to restore the texture.
+func (e *engine) LoadTexture(src image.Image) (sprite.Texture, error) {
+	return e.loadTexture(src, reloadImage(src), "image")
+}
+
+// loadTexture loads specified image as a texture.
+// reload returns the image again to restore the texture after GL context
+// is lost. If reload is nil, pixels of the texture are kept on CPU instead.
+// source describes where the image came from.
+func (e *engine) loadTexture(src image.Image, reload func() (image.Image, error), source string) (*texture, error) {
+	b := src.Bounds()
+	t := &texture{
+		e:      e,
+		b:      b,
+		width:  roundToPower2(b.Dx()),
+		height: roundToPower2(b.Dy()),
+		reload: reload,
+		source: source,
+	}
+	pix := t.pad(src)
+	if reload == nil {
+		t.rgba = pix
+	}
+	if err := t.create(pix); err != nil {
+		return nil, err
+	}
+	e.textures[t] = struct{}{}
+	return t, nil
+}
+
+// reloadImage returns a function that returns img as it is
+func reloadImage(img image.Image) func() (image.Image, error) {
+	return func() (image.Image, error) {
+		return img, nil
+	}
+}
+
+// SetSubTex sets sub texture to a node
+func (e *engine) SetSubTex(n *sprite.Node, x sprite.SubTex) {
+	n.EngineFields.Dirty = true
+	n.EngineFields.SubTex = x
+}
+
+// SetTransform sets transform relative to parent
+func (e *engine) SetTransform(n *sprite.Node, m f32.Affine) {
+	n.EngineFields.Dirty = true
+	e.nodes[n.EngineFields.Index].relTransform = m
+}
+
+// SetColor sets alpha and color tint of a node
+func (e *engine) SetColor(n *sprite.Node, tint color.RGBA, alpha float32) {
+	e.nodes[n.EngineFields.Index].tint = premultipliedTint(tint, alpha)
+}
+
+// SetBlendMode sets blend mode of a node
+func (e *engine) SetBlendMode(n *sprite.Node, mode BlendMode) {
+	e.nodes[n.EngineFields.Index].blend = mode
+}
+
+// SetClip sets scissor box of a node in pixels of framebuffer.
+// The node is not clipped if clipped is false.
+func (e *engine) SetClip(n *sprite.Node, scissor [4]int32, clipped bool) {
+	ns := e.nodes[n.EngineFields.Index]
+	ns.scissor = scissor
+	ns.clipped = clipped
+}
+
+// SetMask sets mask of a node. nil removes the mask.
+func (e *engine) SetMask(n *sprite.Node, mask *nodeMask) {
+	e.nodes[n.EngineFields.Index].mask = mask
+}
+
+// SetMaterial sets material of a node. nil means the default drawing.
+func (e *engine) SetMaterial(n *sprite.Node, m *Material) {
+	e.nodes[n.EngineFields.Index].material = m
+}
+
+// SetPieces sets pieces of nine-sliced or repeated texture drawn by the node.
+// nil draws whole sub texture.
+func (e *engine) SetPieces(n *sprite.Node, pieces []slicePiece) {
+	e.nodes[n.EngineFields.Index].pieces = pieces
+}
+
+// Render renders the node and its children.
+// Nodes may be left in the batch, so that flush must be called after
+// rendering all nodes of a frame.
+func (e *engine) Render(scene *sprite.Node, t clock.Time, sz size.Event) {
+	e.absTransforms = append(e.absTransforms[:0], f32.Affine{
+		{1, 0, 0},
+		{0, 1, 0},
+	})
+	e.render(scene, t, sz)
+}
+
+func (e *engine) render(n *sprite.Node, t clock.Time, sz size.Event) {
+	if n.EngineFields.Index == 0 {
+		panic("engine: sprite.Node not registered")
+	}
+	e.Arrange(n, t)
+
+	ns := e.nodes[n.EngineFields.Index]
+	m := f32.Affine{}
+	m.Mul(&e.absTransforms[len(e.absTransforms)-1], &ns.relTransform)
+	e.absTransforms = append(e.absTransforms, m)
+
+	if x := n.EngineFields.SubTex; x.T != nil && ns.tint[3] > 0 {
+		e.drawn++
+		t := x.T.(*texture)
+		if ns.pieces == nil {
+			e.drawNode(t, x.R, nil, &m, ns, sz)
+		}
+		for i := range ns.pieces {
+			p := &ns.pieces[i]
+			var pm f32.Affine
+			pm.Mul(&m, &p.m)
+			e.drawNode(t, p.r, &p.uv, &pm, ns, sz)
+		}
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		e.render(c, t, sz)
+	}
+
+	e.absTransforms = e.absTransforms[:len(e.absTransforms)-1]
+}
+
+// drawNode draws sub rectangle r of texture t for the node, in a batch if possible.
+// uv maps the unit square to the area drawn in r. nil draws whole r.
+func (e *engine) drawNode(t *texture, r image.Rectangle, uv, m *f32.Affine, ns *nodeState, sz size.Event) {
+	if batchable(ns) {
+		e.appendBatch(t, r, uv, m, ns, sz)
+		return
+	}
+	// keep the order of drawing
+	e.flush()
+	e.draw(t, r, uv, m, ns, sz)
+}
+
+func (e *engine) draw(t *texture, r image.Rectangle, uv, m *f32.Affine, ns *nodeState, sz size.Event) {
+	glctx := e.glctx
+
+	if ns.mask != nil && e.stencil {
+		e.drawStencil(ns.mask, sz)
+	}
+	if ns.clipped {
+		glctx.Enable(gl.SCISSOR_TEST)
+		glctx.Scissor(ns.scissor[0], ns.scissor[1], ns.scissor[2], ns.scissor[3])
+	} else {
+		glctx.Disable(gl.SCISSOR_TEST)
+	}
+	p := e.programOf(ns.material)
+	e.use(p)
+	glctx.Uniform1f(p.maskEnabled, 0)
+	if ns.mask != nil && !e.stencil {
+		e.useMask(ns.mask, sz)
+	}
+	if ns.material != nil {
+		e.bindMaterial(ns.material)
+	}
+
+	src, dst := ns.blend.blendFunc()
+	glctx.Enable(gl.BLEND)
+	glctx.BlendEquation(gl.FUNC_ADD)
+	glctx.BlendFunc(src, dst)
+	glctx.Uniform4f(p.tint, ns.tint[0], ns.tint[1], ns.tint[2], ns.tint[3])
+	glctx.Uniform1f(p.alphaThreshold, 0)
+	e.drawQuad(t, r, uv, m, sz)
+	e.drawCalls++
+	glctx.Disable(gl.BLEND)
+	glctx.Disable(gl.STENCIL_TEST)
+}
+
+// use makes specified program current
+func (e *engine) use(p *shaderProgram) {
+	e.glctx.UseProgram(p.program)
+	e.current = p
+}
+
+// programOf returns program of specified material.
+// The default program is returned if material is nil or failed to compile.
+func (e *engine) programOf(m *Material) *shaderProgram {
+	if m == nil {
+		return e.def
+	}
+	p, ok := e.programs[m.source]
+	if !ok {
+		var err error
+		p, err = e.compile(m)
+		if err != nil {
+			simlog.Error(err)
+		}
+	}
+	if p == nil {
+		return e.def
+	}
+	return p
+}
+
+// compile compiles source of specified material and caches the program
+func (e *engine) compile(m *Material) (*shaderProgram, error) {
+	if p, ok := e.programs[m.source]; ok && p != nil {
+		return p, nil
+	}
+	p, err := newShaderProgram(e.glctx, m.source)
+	// failed source is also cached not to compile every frame
+	e.programs[m.source] = p
+	return p, err
+}
+
+// bindMaterial sets uniforms and textures of material to current program
+func (e *engine) bindMaterial(m *Material) {
+	glctx := e.glctx
+	p := e.current
+	for name, v := range m.uniforms {
+		u := p.uniform(glctx, name)
+		switch v := v.(type) {
+		case float32:
+			glctx.Uniform1f(u, v)
+		case [2]float32:
+			glctx.Uniform2f(u, v[0], v[1])
+		case [4]float32:
+			glctx.Uniform4f(u, v[0], v[1], v[2], v[3])
+		}
+	}
+	// texture unit 0 and 1 are used by sprite's texture and mask
+	for i, name := range m.samplerNames() {
+		var gltex gl.Texture
+		if t, ok := m.textures[name]; ok {
+			if tex, ok := t.subTex.T.(*texture); ok {
+				gltex = tex.gltex
+			}
+		} else if tex, err := e.imageTexture(m.images[name]); err == nil {
+			gltex = tex.gltex
+		} else {
+			// sampler reads nothing from the zero texture
+			simlog.Error(err)
+		}
+		glctx.ActiveTexture(gl.Enum(gl.TEXTURE2 + i))
+		glctx.BindTexture(gl.TEXTURE_2D, gltex)
+		glctx.Uniform1i(p.uniform(glctx, name), 2+i)
+	}
+}
+
+// imageTexture returns texture of specified image, loading it at first.
+// Failed load is not cached, so that it is tried again at the next use.
+func (e *engine) imageTexture(img image.Image) (*texture, error) {
+	if t, ok := e.images[img]; ok {
+		return t, nil
+	}
+	t, err := e.loadTexture(img, reloadImage(img), "image")
+	if err != nil {
+		return nil, err
+	}
+	e.images[img] = t
+	return t, nil
+}
+
+// drawStencil draws opaque area of mask into stencil buffer,
+// then configures stencil test to draw only in the area.
+// The buffer is cleared and drawn only if it holds another mask, so that
+// consecutive nodes of the same mask share it.
+func (e *engine) drawStencil(mask *nodeMask, sz size.Event) {
+	glctx := e.glctx
+	glctx.Enable(gl.STENCIL_TEST)
+	if e.stencilMask != nil && *e.stencilMask == *mask {
+		return
+	}
+	p := e.def
+	e.use(p)
+	// whole mask is drawn regardless of clip rectangle of the node
+	glctx.Disable(gl.SCISSOR_TEST)
+	glctx.ClearStencil(0)
+	glctx.Clear(gl.STENCIL_BUFFER_BIT)
+	glctx.StencilFunc(gl.ALWAYS, 1, 0xff)
+	glctx.StencilOp(gl.KEEP, gl.KEEP, gl.REPLACE)
+	glctx.ColorMask(false, false, false, false)
+	glctx.Uniform1f(p.maskEnabled, 0)
+	glctx.Uniform4f(p.tint, 1, 1, 1, 1)
+	// transparent pixels of mask are discarded not to update stencil
+	glctx.Uniform1f(p.alphaThreshold, mask.threshold)
+	e.drawQuad(mask.tex, mask.r, nil, &mask.m, sz)
+	glctx.ColorMask(true, true, true, true)
+	glctx.StencilFunc(gl.EQUAL, 1, 0xff)
+	glctx.StencilOp(gl.KEEP, gl.KEEP, gl.KEEP)
+	m := *mask
+	e.stencilMask = &m
+}
+
+// useMask configures current program to sample mask texture.
+// This is used if framebuffer has no stencil buffer.
+func (e *engine) useMask(mask *nodeMask, sz size.Event) {
+	glctx := e.glctx
+	p := e.current
+	// maskInv maps clip space to the unit square of mask
+	mvp := e.calcMVP(&mask.m, sz)
+	var inv f32.Affine
+	inv.Inverse(&mvp)
+	quadToUnit := f32.Affine{
+		{0.5, 0, 0.5},
+		{0, -0.5, 0.5},
+	}
+	var maskInv f32.Affine
+	maskInv.Mul(&quadToUnit, &inv)
+	writeAffine(glctx, p.maskInv, &maskInv)
+	uvp := calcUVP(mask.r, mask.tex.width, mask.tex.height)
+	writeAffine(glctx, p.maskUVP, &uvp)
+	glctx.Uniform1f(p.maskThreshold, mask.threshold)
+	glctx.Uniform1f(p.maskEnabled, 1)
+
+	glctx.ActiveTexture(gl.TEXTURE1)
+	glctx.BindTexture(gl.TEXTURE_2D, mask.tex.gltex)
+	glctx.Uniform1i(p.maskSample, 1)
+}
+
+// calcMVP calculates mvp for current framebuffer
+func (e *engine) calcMVP(m *f32.Affine, sz size.Event) f32.Affine {
+	mvp := calcMVP(m, float32(sz.WidthPt), float32(sz.HeightPt))
+	if e.flipY {
+		mvp[1] = [3]float32{-mvp[1][0], -mvp[1][1], -mvp[1][2]}
+	}
+	return mvp
+}
+
+// drawQuad draws sub rectangle r of texture t to the parallelogram represented by m.
+// uv maps the unit square to the area drawn in r. nil draws whole r.
+func (e *engine) drawQuad(t *texture, r image.Rectangle, uv, m *f32.Affine, sz size.Event) {
+	glctx := e.glctx
+	p := e.current
+
+	mvp := e.calcMVP(m, sz)
+	writeAffine(glctx, p.mvp, &mvp)
+	uvp := cropUVP(calcUVP(r, t.width, t.height), uv)
+	writeAffine(glctx, p.uvp, &uvp)
+	glctx.Uniform2f(p.texelSize, 1/float32(t.width), 1/float32(t.height))
+	glctx.Uniform4f(p.uvRect, uvp[0][2], uvp[1][2], uvp[0][2]+uvp[0][0], uvp[1][2]+uvp[1][1])
+
+	glctx.ActiveTexture(gl.TEXTURE0)
+	glctx.BindTexture(gl.TEXTURE_2D, t.gltex)
+	glctx.Uniform1i(p.sample, 0)
+
+	glctx.BindBuffer(gl.ARRAY_BUFFER, e.quadXY)
+	glctx.EnableVertexAttribArray(p.pos)
+	glctx.VertexAttribPointer(p.pos, 2, gl.FLOAT, false, 0, 0)
+
+	glctx.BindBuffer(gl.ARRAY_BUFFER, e.quadUV)
+	glctx.EnableVertexAttribArray(p.inUV)
+	glctx.VertexAttribPointer(p.inUV, 2, gl.FLOAT, false, 0, 0)
+
+	glctx.DrawArrays(gl.TRIANGLE_STRIP, 0, 4)
+
+	glctx.DisableVertexAttribArray(p.pos)
+	glctx.DisableVertexAttribArray(p.inUV)
+}
+
+// Release releases all textures and GL resources held by engine
+func (e *engine) Release() {
+	e.suspend()
+	e.textures = make(map[*texture]struct{})
+	e.images = make(map[image.Image]*texture)
+}
+
+// suspend deletes GL objects of engine since GL context is going to be lost.
+// Sources of textures and states of nodes are kept to be restored.
+func (e *engine) suspend() {
+	for t := range e.textures {
+		if t.gltex != (gl.Texture{}) {
+			e.glctx.DeleteTexture(t.gltex)
+			t.gltex = gl.Texture{}
+		}
+	}
+	for _, p := range e.programs {
+		if p != nil {
+			e.glctx.DeleteProgram(p.program)
+		}
+	}
+	// materials are compiled again when they are drawn
+	e.programs = make(map[string]*shaderProgram)
+	e.current = nil
+	e.batch.reset()
+	if e.def != nil {
+		e.glctx.DeleteProgram(e.def.program)
+		e.glctx.DeleteProgram(e.batchProgram.program)
+		e.glctx.DeleteBuffer(e.quadXY)
+		e.glctx.DeleteBuffer(e.quadUV)
+		e.glctx.DeleteBuffer(e.batchVertices)
+		e.glctx.DeleteBuffer(e.batchIndices)
+		e.def = nil
+		e.batchProgram = nil
+	}
+}
+
+// calcMVP calculates a matrix that maps the unit quad (-1..+1) to
+// clip space for the parallelogram represented by m.
+// m maps unit square (0..1) to the screen in pt, whose origin is top left.
+func calcMVP(m *f32.Affine, widthPt, heightPt float32) f32.Affine {
+	// corners of the parallelogram in clip space, divided by 2.
+	px2 := -0.5 + m[0][2]/widthPt
+	py2 := +0.5 - m[1][2]/heightPt
+	qx2 := -0.5 + (m[0][2]+m[0][0])/widthPt
+	qy2 := +0.5 - (m[1][2]+m[1][0])/heightPt
+	sx2 := -0.5 + (m[0][2]+m[0][1])/widthPt
+	sy2 := +0.5 - (m[1][2]+m[1][1])/heightPt
+	return f32.Affine{
+		{qx2 - px2, px2 - sx2, qx2 + sx2},
+		{qy2 - py2, py2 - sy2, qy2 + sy2},
+	}
+}
+
+// calcUVP calculates a matrix that maps the unit texture coordinates (0..1)
+// to the coordinates of sub rectangle r in a texture of specified size.
+func calcUVP(r image.Rectangle, width, height int) f32.Affine {
+	w, h := float32(width), float32(height)
+	px := float32(r.Min.X) / w
+	py := float32(r.Min.Y) / h
+	qx := float32(r.Max.X) / w
+	sy := float32(r.Max.Y) / h
+	return f32.Affine{
+		{qx - px, 0, px},
+		{0, sy - py, py},
+	}
+}
+
+// identityAffine maps the unit square to itself
+var identityAffine = f32.Affine{
+	{1, 0, 0},
+	{0, 1, 0},
+}
+
+// cropUVP returns uvp that maps the unit square to the area of the sub
+// rectangle that uv maps the unit square to. uvp is returned if uv is nil.
+func cropUVP(uvp f32.Affine, uv *f32.Affine) f32.Affine {
+	if uv == nil {
+		return uvp
+	}
+	var m f32.Affine
+	m.Mul(&uvp, uv)
+	return m
+}
+
+func writeAffine(glctx gl.Context, u gl.Uniform, a *f32.Affine) {
+	var m [9]float32
+	m[0*3+0] = a[0][0]
+	m[0*3+1] = a[1][0]
+	m[0*3+2] = 0
+	m[1*3+0] = a[0][1]
+	m[1*3+1] = a[1][1]
+	m[1*3+2] = 0
+	m[2*3+0] = a[0][2]
+	m[2*3+1] = a[1][2]
+	m[2*3+2] = 1
+	glctx.UniformMatrix3fv(u, m[:])
+}
+
+func roundToPower2(x int) int {
+	x2 := 1
+	for x2 < x {
+		x2 *= 2
+	}
+	return x2
+}
+
+// texture is an implementation of sprite.Texture for engine
+type texture struct {
+	e     *engine
+	gltex gl.Texture
+	// rgba is pixels of the texture on CPU, padded to power of 2.
+	// It is loaded by reload when it is needed by rendering on CPU,
+	// hit test or updates. It is always kept if reload is nil.
+	rgba   *image.RGBA
+	b      image.Rectangle
+	width  int
+	height int
+	// reload returns the image of the texture again, to restore the texture
+	// after GL context is lost. nil if the image can not be reproduced.
+	reload func() (image.Image, error)
+	// source describes where pixels of the texture came from
+	source string
+}
+
+// pad returns pixels of img placed at top left of the texture
+func (t *texture) pad(img image.Image) *image.RGBA {
+	pix := image.NewRGBA(image.Rect(0, 0, t.width, t.height))
+	b := img.Bounds()
+	draw.Draw(pix, b.Sub(b.Min), img, b.Min, draw.Src)
+	return pix
+}
+
+// load returns pixels of the texture reproduced by reload
+func (t *texture) load() (*image.RGBA, error) {
+	if t.reload == nil {
+		return nil, fmt.Errorf("pixels of %s are not kept", t.source)
+	}
+	img, err := t.reload()
+	if err != nil {
+		return nil, err
+	}
+	return t.pad(img), nil
+}
+
+// pixels returns pixels of the texture on CPU, loading them at first.
+// Transparent pixels are returned if they can not be loaded.
+func (t *texture) pixels() *image.RGBA {
+	if t.rgba != nil {
+		return t.rgba
+	}
+	pix, err := t.load()
+	if err != nil {
+		simlog.Error(err)
+		pix = image.NewRGBA(image.Rect(0, 0, t.width, t.height))
+	}
+	t.rgba = pix
+	return pix
+}
+
+// Bounds returns size of the texture
+func (t *texture) Bounds() (w, h int) {
+	return t.b.Dx(), t.b.Dy()
+}
+
+// Download copies pixels of the texture to dst
+func (t *texture) Download(r image.Rectangle, dst draw.Image) {
+	draw.Draw(dst, r, t.pixels(), r.Min.Sub(t.b.Min), draw.Src)
+}
+
+// Upload copies pixels of src to the texture.
+// The pixels are kept on CPU since they can not be reproduced.
+func (t *texture) Upload(r image.Rectangle, src image.Image) {
+	draw.Draw(t.pixels(), r.Sub(t.b.Min), src, src.Bounds().Min, draw.Src)
+	t.reload = nil
+	if t.gltex == (gl.Texture{}) {
+		return
+	}
+	glctx := t.e.glctx
+	glctx.BindTexture(gl.TEXTURE_2D, t.gltex)
+	glctx.TexSubImage2D(gl.TEXTURE_2D, 0, 0, 0, t.width, t.height, gl.RGBA, gl.UNSIGNED_BYTE, t.rgba.Pix)
+}
+
+// draw draws src to r of the texture, whose origin is top left of its pixels,
+// and uploads the rows of r to GL texture. Pixels on CPU are dropped after
+// that if they were not loaded and can be reproduced by reload.
+func (t *texture) draw(r image.Rectangle, src image.Image, sp image.Point) {
+	loaded := t.rgba != nil
+	pix := t.pixels()
+	draw.Draw(pix, r, src, sp, draw.Src)
+	if !loaded && t.reload != nil {
+		defer func() { t.rgba = nil }()
+	}
+	if t.gltex == (gl.Texture{}) {
+		return
+	}
+	stride := pix.Stride
+	glctx := t.e.glctx
+	glctx.BindTexture(gl.TEXTURE_2D, t.gltex)
+	glctx.TexSubImage2D(gl.TEXTURE_2D, 0, 0, r.Min.Y, t.width, r.Dy(), gl.RGBA, gl.UNSIGNED_BYTE,
+		pix.Pix[r.Min.Y*stride:r.Max.Y*stride])
+}
+
+// create creates GL texture and uploads pixels of the texture
+func (t *texture) create(pix *image.RGBA) error {
+	glctx := t.e.glctx
+	t.gltex = glctx.CreateTexture()
+	if t.gltex == (gl.Texture{}) {
+		return fmt.Errorf("failed to create texture of %s", t.source)
+	}
+	glctx.BindTexture(gl.TEXTURE_2D, t.gltex)
+	glctx.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA, t.width, t.height, gl.RGBA, gl.UNSIGNED_BYTE, pix.Pix)
+	glctx.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
+	glctx.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR)
+	glctx.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
+	glctx.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
+	return nil
+}
+
+// Release releases GL texture
+func (t *texture) Release() {
+	if t.e == nil {
+		return
+	}
+	// texture may be released while GL context is lost
+	delete(t.e.textures, t)
+	if t.gltex == (gl.Texture{}) {
+		return
+	}
+	t.e.glctx.DeleteTexture(t.gltex)
+	t.gltex = gl.Texture{}
+}
+
+var quadXYCoords = f32.Bytes(binary.LittleEndian,
+	-1, +1, // top left
+	+1, +1, // top right
+	-1, -1, // bottom left
+	+1, -1, // bottom right
+)
+
+var quadUVCoords = f32.Bytes(binary.LittleEndian,
+	0, 0, // top left
+	1, 0, // top right
+	0, 1, // bottom left
+	1, 1, // bottom right
+)
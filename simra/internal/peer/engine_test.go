@@ -0,0 +1,254 @@
+package peer
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"golang.org/x/mobile/exp/f32"
+	"golang.org/x/mobile/gl"
+)
+
+func TestBlendFunc(t *testing.T) {
+	tcs := []struct {
+		mode     BlendMode
+		src, dst gl.Enum
+	}{
+		{mode: BlendNormal, src: gl.ONE, dst: gl.ONE_MINUS_SRC_ALPHA},
+		{mode: BlendAdditive, src: gl.ONE, dst: gl.ONE},
+		{mode: BlendMultiply, src: gl.DST_COLOR, dst: gl.ONE_MINUS_SRC_ALPHA},
+		{mode: BlendScreen, src: gl.ONE, dst: gl.ONE_MINUS_SRC_COLOR},
+	}
+	for i, tc := range tcs {
+		src, dst := tc.mode.blendFunc()
+		if src != tc.src || dst != tc.dst {
+			t.Errorf("[%d] unexpected result. [got] (%v, %v) [want] (%v, %v)", i, src, dst, tc.src, tc.dst)
+		}
+	}
+}
+
+func TestPremultipliedTint(t *testing.T) {
+	tcs := []struct {
+		tint  color.RGBA
+		alpha float32
+		want  [4]float32
+	}{
+		{tint: color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, alpha: 1, want: [4]float32{1, 1, 1, 1}},
+		{tint: color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, alpha: 0.5, want: [4]float32{0.5, 0.5, 0.5, 0.5}},
+		{tint: color.RGBA{R: 0xff, A: 0xff}, alpha: 0.5, want: [4]float32{0.5, 0, 0, 0.5}},
+		{tint: color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, alpha: 2, want: [4]float32{1, 1, 1, 1}},
+	}
+	for i, tc := range tcs {
+		got := premultipliedTint(tc.tint, tc.alpha)
+		if got != tc.want {
+			t.Errorf("[%d] unexpected result. [got] %v [want] %v", i, got, tc.want)
+		}
+	}
+}
+
+func TestCalcMVP(t *testing.T) {
+	// full screen quad is mapped to whole clip space
+	m := &f32.Affine{
+		{100, 0, 0},
+		{0, 200, 0},
+	}
+	got := calcMVP(m, 100, 200)
+	want := f32.Affine{
+		{1, 0, 0},
+		{0, 1, 0},
+	}
+	if got != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+
+	// quarter of the screen at top left
+	m = &f32.Affine{
+		{50, 0, 0},
+		{0, 100, 0},
+	}
+	got = calcMVP(m, 100, 200)
+	want = f32.Affine{
+		{0.5, 0, -0.5},
+		{0, 0.5, 0.5},
+	}
+	if got != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+}
+
+func TestCalcUVP(t *testing.T) {
+	got := calcUVP(image.Rect(16, 32, 48, 64), 64, 128)
+	want := f32.Affine{
+		{0.5, 0, 0.25},
+		{0, 0.25, 0.25},
+	}
+	if got != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+}
+
+func TestRoundToPower2(t *testing.T) {
+	tcs := []struct{ in, want int }{
+		{in: 1, want: 1}, {in: 3, want: 4}, {in: 64, want: 64}, {in: 65, want: 128},
+	}
+	for _, tc := range tcs {
+		if got := roundToPower2(tc.in); got != tc.want {
+			t.Errorf("unexpected result. [got] %d [want] %d", got, tc.want)
+		}
+	}
+}
+
+// mockObjectGLContext is a gl.Context that remembers live GL objects and
+// pixels uploaded to textures
+type mockObjectGLContext struct {
+	gl.Context
+	next     uint32
+	textures map[uint32][]byte
+	programs map[uint32]bool
+	bound    uint32
+	// noTexture makes CreateTexture fail as GL does when it is out of memory
+	noTexture bool
+	// noProgram makes CreateProgram fail
+	noProgram bool
+}
+
+func newMockObjectGLContext() *mockObjectGLContext {
+	return &mockObjectGLContext{
+		textures: make(map[uint32][]byte),
+		programs: make(map[uint32]bool),
+	}
+}
+
+func (m *mockObjectGLContext) newObject() uint32 {
+	m.next++
+	return m.next
+}
+
+func (m *mockObjectGLContext) CreateTexture() gl.Texture {
+	if m.noTexture {
+		return gl.Texture{}
+	}
+	v := m.newObject()
+	m.textures[v] = nil
+	return gl.Texture{Value: v}
+}
+func (m *mockObjectGLContext) DeleteTexture(v gl.Texture)               { delete(m.textures, v.Value) }
+func (m *mockObjectGLContext) BindTexture(target gl.Enum, t gl.Texture) { m.bound = t.Value }
+func (m *mockObjectGLContext) TexImage2D(target gl.Enum, level int, internalFormat int, width, height int, format gl.Enum, ty gl.Enum, data []byte) {
+	m.textures[m.bound] = append([]byte(nil), data...)
+}
+func (m *mockObjectGLContext) TexParameteri(target, pname gl.Enum, param int) {}
+func (m *mockObjectGLContext) CreateProgram() gl.Program {
+	if m.noProgram {
+		return gl.Program{}
+	}
+	v := m.newObject()
+	m.programs[v] = true
+	return gl.Program{Value: v}
+}
+func (m *mockObjectGLContext) DeleteProgram(p gl.Program)                  { delete(m.programs, p.Value) }
+func (m *mockObjectGLContext) GetProgrami(p gl.Program, pname gl.Enum) int { return 1 }
+func (m *mockObjectGLContext) AttachShader(p gl.Program, s gl.Shader)      {}
+func (m *mockObjectGLContext) LinkProgram(p gl.Program)                    {}
+func (m *mockObjectGLContext) CreateShader(ty gl.Enum) gl.Shader {
+	return gl.Shader{Value: m.newObject()}
+}
+func (m *mockObjectGLContext) ShaderSource(s gl.Shader, src string)      {}
+func (m *mockObjectGLContext) CompileShader(s gl.Shader)                 {}
+func (m *mockObjectGLContext) GetShaderi(s gl.Shader, pname gl.Enum) int { return 1 }
+func (m *mockObjectGLContext) DeleteShader(s gl.Shader)                  {}
+func (m *mockObjectGLContext) GetAttribLocation(p gl.Program, name string) gl.Attrib {
+	return gl.Attrib{}
+}
+func (m *mockObjectGLContext) GetUniformLocation(p gl.Program, name string) gl.Uniform {
+	return gl.Uniform{}
+}
+func (m *mockObjectGLContext) GetInteger(pname gl.Enum) int                         { return 0 }
+func (m *mockObjectGLContext) CreateBuffer() gl.Buffer                              { return gl.Buffer{Value: m.newObject()} }
+func (m *mockObjectGLContext) DeleteBuffer(v gl.Buffer)                             {}
+func (m *mockObjectGLContext) BindBuffer(target gl.Enum, b gl.Buffer)               {}
+func (m *mockObjectGLContext) BufferData(target gl.Enum, src []byte, usage gl.Enum) {}
+
+func TestEngineSuspendAndRestore(t *testing.T) {
+	lost := newMockObjectGLContext()
+	e, err := newEngine(lost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	img.Set(1, 1, color.RGBA{R: 255, A: 255})
+	reloads := 0
+	reload := func() (image.Image, error) {
+		reloads++
+		return img, nil
+	}
+	tex, err := e.loadTexture(img, reload, "asset:test.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tex.rgba != nil {
+		t.Errorf("pixels should not be kept on CPU")
+	}
+	// pixels kept on CPU are restored without reloading
+	kept, err := e.loadTexture(img, nil, "render target")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e.suspend()
+	if len(lost.textures) != 0 || len(lost.programs) != 0 {
+		t.Errorf("GL objects should be deleted. [got] %d textures, %d programs [want] none",
+			len(lost.textures), len(lost.programs))
+	}
+
+	glctx := newMockObjectGLContext()
+	if err := e.restore(glctx); err != nil {
+		t.Fatal(err)
+	}
+	if tex.gltex == (gl.Texture{}) {
+		t.Fatalf("texture should be created again")
+	}
+	if reloads != 1 {
+		t.Errorf("unexpected result. [got] %d [want] %d", reloads, 1)
+	}
+	if tex.rgba != nil {
+		t.Errorf("pixels should not be kept on CPU after restoring")
+	}
+	got := glctx.textures[tex.gltex.Value]
+	if want := kept.rgba.Pix; string(got) != string(want) {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+	if got := kept.rgba.RGBAAt(1, 1); got != (color.RGBA{R: 255, A: 255}) {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, color.RGBA{R: 255, A: 255})
+	}
+	if got := glctx.textures[kept.gltex.Value]; string(got) != string(kept.rgba.Pix) {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, kept.rgba.Pix)
+	}
+
+	// released texture is not restored
+	e.suspend()
+	tex.Release()
+	if err := e.restore(newMockObjectGLContext()); err != nil {
+		t.Fatal(err)
+	}
+	if tex.gltex != (gl.Texture{}) {
+		t.Errorf("released texture should not be created")
+	}
+}
+
+func TestEngineSharesProgramsBySource(t *testing.T) {
+	glctx := newMockObjectGLContext()
+	e, err := newEngine(glctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := len(glctx.programs)
+	a := NewDissolve(0.2, color.RGBA{}, 1)
+	b := NewDissolve(0.8, color.RGBA{}, 2)
+	if e.programOf(a) != e.programOf(b) {
+		t.Errorf("materials of the same source should share a program")
+	}
+	if got := len(glctx.programs) - n; got != 1 {
+		t.Errorf("unexpected result. [got] %d [want] %d", got, 1)
+	}
+}
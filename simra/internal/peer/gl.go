@@ -1,10 +1,10 @@
 package peer
 
 import (
+	"fmt"
 	"image"
 	"image/color"
 	"image/draw"
-	"log"
 	"sort"
 	"sync"
 	"time"
@@ -16,13 +16,11 @@ import (
 	"golang.org/x/image/font/gofont/goregular"
 	"golang.org/x/image/math/fixed"
 	"golang.org/x/mobile/app"
-	"golang.org/x/mobile/asset"
 	"golang.org/x/mobile/exp/app/debug"
 	"golang.org/x/mobile/exp/f32"
 	"golang.org/x/mobile/exp/gl/glutil"
 	"golang.org/x/mobile/exp/sprite"
 	"golang.org/x/mobile/exp/sprite/clock"
-	"golang.org/x/mobile/exp/sprite/glsprite"
 	"golang.org/x/mobile/gl"
 )
 
@@ -30,14 +28,14 @@ import (
 type GLer interface {
 	// Initialize initializes GLPeer.
 	// This function must be called in advance of using GLPeer
-	Initialize(glc *GLContext)
+	Initialize(glc *GLContext) error
 	// LoadTexture return texture that is loaded by the information of arguments.
-	// Loaded texture can assign using AddSprite function.
-	LoadTexture(assetName string, rect image.Rectangle) sprite.SubTex
+	// Loaded texture can assign using ReplaceTexture function.
+	LoadTexture(assetName string, rect image.Rectangle) *Texture
 	// MakeTextureByText create and return texture by specified text
-	// Loaded texture can assign using AddSprite function.
+	// Loaded texture can assign using ReplaceTexture function.
 	// TODO: font parameterize
-	MakeTextureByText(text string, fontsize float64, fontcolor color.RGBA, rect image.Rectangle) sprite.SubTex
+	MakeTextureByText(text string, fontsize float64, fontcolor color.RGBA, rect image.Rectangle) *Texture
 	// Finalize finalizes GLPeer.
 	// This is called at termination of application.
 	Finalize()
@@ -48,7 +46,7 @@ type GLer interface {
 	// All sprites are also cleaned.
 	// This is called at changing of scene, and
 	// this function is for clean previous scene.
-	Reset()
+	Reset() error
 	// NewTexture returns a new Texture instance
 	NewTexture(s sprite.SubTex) *Texture
 	// ReleaseTexture releases specified texture
@@ -61,27 +59,124 @@ type GLer interface {
 	RemoveNode(n *ZNode)
 	// SetSubTex registers subtexture to specified node
 	SetSubTex(n *ZNode, subTex *sprite.SubTex)
-	// ZIndexDirty updates dirty flag with specified argument
-	ZIndexDirty()
+	// NewRenderTarget returns a render target that has specified size in pixels
+	NewRenderTarget(width, height int) (*RenderTarget, error)
+	// RenderTo renders sprites of specified render target into it
+	RenderTo(rt *RenderTarget)
+	// ReleaseRenderTarget releases specified render target
+	ReleaseRenderTarget(rt *RenderTarget)
+	// CompileMaterial compiles specified material and returns error of compilation
+	CompileMaterial(m *Material) error
+	// ZIndexDirty marks the layer of specified node to be sorted by zindex
+	ZIndexDirty(n *ZNode)
+	// GetRenderStats returns statistics of rendering of the last frame
+	GetRenderStats() RenderStats
+	// LoadAtlas loads TexturePacker's JSON data and returns textures of its frames
+	LoadAtlas(assetName string) (*Atlas, error)
+	// GetTextureStats returns statistics of textures loaded from assets
+	GetTextureStats() TextureStats
+	// GetLiveTextures returns textures held by GL with their sources
+	GetLiveTextures() []TextureInfo
+	// DiscardTexture marks specified texture to be released at the next Update
+	DiscardTexture(t *Texture)
+	// Suspend releases GL resources since GL context is going to be lost
+	Suspend()
+	// Resume restores GL resources released by Suspend with new GL context
+	Resume(glc *GLContext) error
+	// NewTextureFromImage returns a texture that has pixels of specified image
+	NewTextureFromImage(img image.Image) *Texture
+	// NewTextureFromBytes returns a texture of image encoded in specified bytes
+	NewTextureFromBytes(b []byte) (*Texture, error)
+	// UpdateTexture replaces pixels of the texture with src at (x, y) of the texture
+	UpdateTexture(t *Texture, x, y int, src image.Image)
+	// DrawShape rasterizes specified shape into t, or into a new texture if
+	// the shape does not fit to t
+	DrawShape(t *Texture, sh *Shape, ppu float32) (*Texture, float32, float32)
+	// LoadNinePatch loads Android's nine-patch image and returns its texture and insets
+	LoadNinePatch(assetName string) (*Texture, *NineSlice, error)
+	// AddLayer adds a layer that has specified name and order
+	AddLayer(name string, order int) (*Layer, error)
+	// GetLayer returns the layer that has specified name. nil if not found.
+	GetLayer(name string) *Layer
+	// GetLayers returns layers from back to front
+	GetLayers() []*Layer
+	// RemoveAllLayers removes all layers and resets the default layer
+	RemoveAllLayers()
+	// GetCamera returns the main camera
+	GetCamera() *Camera
+	// AddViewport adds specified viewport to the screen
+	AddViewport(vp *Viewport)
+	// RemoveViewport removes specified viewport from the screen
+	RemoveViewport(vp *Viewport)
+	// RemoveAllViewports removes all viewports
+	RemoveAllViewports()
 }
 
 // GLPeer represents gl context.
 // Singleton.
 type GLPeer struct {
-	glc         *GLContext
-	startTime   time.Time
-	images      *glutil.Images
-	fps         *debug.FPS
-	eng         sprite.Engine
-	znodes      ZNodes
-	mu          sync.Mutex
-	zindexDirty bool
+	glc       *GLContext
+	startTime time.Time
+	images    *glutil.Images
+	fps       *debug.FPS
+	eng       *engine
+	// znodes are all nodes in added order
+	znodes ZNodes
+	mu     sync.Mutex
+	// layerVersion is the version of layers that layerNodes are grouped by
+	layerVersion int
+	// layoutDirty is true if nodes should be grouped by layers again
+	layoutDirty bool
+	// layerList is layers from back to front
+	layerList []*Layer
+	// layerNodes are nodes grouped by layers, in the order of drawing
+	layerNodes []ZNodes
+	// layerOrder looks up index of layers in layerList
+	layerOrder layerOrder
+	// dirtyLayers are indices of layers that should be sorted by zindex
+	dirtyLayers map[int]bool
+	// nodeIndex looks up nodes by sprites they draw
+	nodeIndex map[*Sprite]*ZNode
+	// scene holds layers, viewports and the main camera
+	scene     *scene
+	sceneOnce sync.Once
+	// targets are render targets of current scene
+	targets []*RenderTarget
+	// stats is statistics of rendering of the last frame
+	stats RenderStats
+	// textures shares textures loaded from assets
+	textures textureCache
+	// discarded are textures to be released at the next Update
+	discarded []*Texture
 }
 
 // ZNode represents node with zindex
 type ZNode struct {
 	Node   *sprite.Node
 	ZIndex int
+	// sprite is the sprite drawn by the node. nil if unknown.
+	sprite *Sprite
+	// arrange is called every frame before the node is drawn. nil if not necessary.
+	arrange func()
+	// transform remembers inputs of the transform set to the node
+	transform transformCache
+	// state remembers rendering state other than transform set to the node
+	state stateCache
+	// order is index of the node in added order
+	order int
+	// sortY is world y of the node, computed once per frame to sort by y
+	sortY float32
+	// culled is true if the node is out of the visible area
+	culled bool
+	// trim maps the unit square to the area of trimmed texture. nil if not
+	// trimmed nor rotated.
+	trim *f32.Affine
+	// rotated is true if the texture is rotated in its sheet
+	rotated bool
+	// pieces are pieces of nine-sliced or repeated texture drawn by the node
+	pieces []slicePiece
+	// wrapSpans are buffers of spans along x and y of repeated texture
+	wrapSpans [2][]wrapSpan
 }
 
 // ZNodes represents array of ZNode
@@ -113,7 +208,7 @@ type GLContext struct {
 
 // Initialize initializes GLPeer.
 // This function must be called in advance of using GLPeer
-func (glpeer *GLPeer) Initialize(glc *GLContext) {
+func (glpeer *GLPeer) Initialize(glc *GLContext) error {
 	simlog.FuncIn()
 
 	glpeer.mu.Lock()
@@ -123,23 +218,33 @@ func (glpeer *GLPeer) Initialize(glc *GLContext) {
 
 	glctx := glc.glcontext
 
-	// transparency of png
-	glctx.Enable(gl.BLEND)
-	glctx.BlendEquation(gl.FUNC_ADD)
-	glctx.BlendFunc(gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA)
+	// blending is configured by engine for each sprite
 	glpeer.images = glutil.NewImages(glctx)
 	glpeer.fps = debug.NewFPS(glpeer.images)
-	glpeer.initEng()
+	err := glpeer.initEng()
 
 	simlog.FuncOut()
+	return err
 }
 
-func (glpeer *GLPeer) initEng() {
+// initEng replaces engine with new one, releasing resources of the old one
+func (glpeer *GLPeer) initEng() error {
 	if glpeer.eng != nil {
 		glpeer.eng.Release()
 	}
-	glpeer.eng = glsprite.Engine(glpeer.images)
+	glpeer.textures.clear()
+	for _, rt := range glpeer.targets {
+		glpeer.releaseTarget(rt)
+	}
+	glpeer.targets = nil
+	eng, err := newEngine(glpeer.glc.glcontext)
+	if err != nil {
+		return fmt.Errorf("failed to create engine: %v", err)
+	}
+	glpeer.eng = eng
 	glpeer.znodes = make([]*ZNode, 0)
+	glpeer.layoutDirty = true
+	return nil
 }
 
 type arrangerFunc func(e sprite.Engine, n *sprite.Node, t clock.Time)
@@ -160,6 +265,10 @@ func (glpeer *GLPeer) AppendNode(zn *ZNode) {
 	glpeer.mu.Lock()
 	defer glpeer.mu.Unlock()
 	glpeer.znodes = append(glpeer.znodes, zn)
+	glpeer.layoutDirty = true
+	if zn.sprite != nil {
+		zn.sprite.scene = glpeer.getScene()
+	}
 }
 
 // RemoveNode removes specified node
@@ -175,52 +284,88 @@ func (glpeer *GLPeer) RemoveNode(n *ZNode) {
 		}
 	}
 	glpeer.znodes = znodes
+	glpeer.layoutDirty = true
 }
 
 // LoadTexture return texture that is loaded by the information of arguments.
-// Loaded texture can assign using AddSprite function.
-func (glpeer *GLPeer) LoadTexture(assetName string, rect image.Rectangle) sprite.SubTex {
+// Loaded texture can assign using ReplaceTexture function.
+// Textures of the same asset share the image and GL texture, that is
+// released when all of them are released.
+func (glpeer *GLPeer) LoadTexture(assetName string, rect image.Rectangle) *Texture {
 	simlog.FuncIn()
 
 	glpeer.mu.Lock()
 	defer glpeer.mu.Unlock()
 
-	a, err := asset.Open(assetName)
+	e, err := glpeer.textures.load(assetName, func() (sprite.Texture, error) {
+		return glpeer.loadAsset(assetName)
+	})
 	if err != nil {
 		simlog.Error(err)
+		simlog.FuncOut()
+		return &Texture{subTex: sprite.SubTex{R: rect}}
 	}
-	defer func() {
-		closeErr := a.Close()
-		if closeErr != nil {
-			simlog.Error(closeErr)
-		}
-	}()
 
-	img, _, err := image.Decode(a)
-	if err != nil {
-		simlog.Error(err)
+	simlog.FuncOut()
+	return &Texture{
+		subTex: sprite.SubTex{T: e.tex, R: rect},
+		entry:  e,
 	}
-	t, err := glpeer.eng.LoadTexture(img)
+}
+
+// loadAsset decodes specified image asset and loads it as a texture,
+// that is restored by decoding the asset again
+func (glpeer *GLPeer) loadAsset(assetName string) (sprite.Texture, error) {
+	img, err := decodeAsset(assetName)
 	if err != nil {
-		simlog.Error(err)
+		return nil, err
 	}
-
-	simlog.FuncOut()
-	return sprite.SubTex{T: t, R: rect}
+	return glpeer.eng.loadTexture(img, func() (image.Image, error) {
+		return decodeAsset(assetName)
+	}, "asset:"+assetName)
 }
 
 // MakeTextureByText create and return texture by specified text
-// Loaded texture can assign using AddSprite function.
+// Loaded texture can assign using ReplaceTexture function.
 // TODO: font parameterize
-func (glpeer *GLPeer) MakeTextureByText(text string, fontsize float64, fontcolor color.RGBA, rect image.Rectangle) sprite.SubTex {
+func (glpeer *GLPeer) MakeTextureByText(text string, fontsize float64, fontcolor color.RGBA, rect image.Rectangle) *Texture {
 	simlog.FuncIn()
 
 	glpeer.mu.Lock()
 	defer glpeer.mu.Unlock()
 
-	dpi := float64(72)
 	width := rect.Dx()
 	height := rect.Dy()
+	img, err := drawText(text, fontsize, fontcolor, width, height)
+	if err != nil {
+		simlog.Error(err)
+		simlog.FuncOut()
+		return &Texture{subTex: sprite.SubTex{R: rect}}
+	}
+	// the texture is restored by drawing the text again
+	t, err := glpeer.eng.loadTexture(img, func() (image.Image, error) {
+		img, err := drawText(text, fontsize, fontcolor, width, height)
+		if err != nil {
+			return nil, err
+		}
+		return img, nil
+	}, "text:"+text)
+	if err != nil {
+		simlog.Error(err)
+		simlog.FuncOut()
+		return &Texture{subTex: sprite.SubTex{R: rect}}
+	}
+
+	simlog.FuncOut()
+	return &Texture{
+		subTex: sprite.SubTex{T: t, R: rect},
+	}
+}
+
+// drawText returns an image of width x height that has the text drawn
+// at the center
+func drawText(text string, fontsize float64, fontcolor color.RGBA, width, height int) (*image.RGBA, error) {
+	dpi := float64(72)
 	img := image.NewRGBA(image.Rect(0, 0, width, height))
 
 	fg, bg := image.NewUniform(fontcolor), image.Transparent
@@ -231,7 +376,7 @@ func (glpeer *GLPeer) MakeTextureByText(text string, fontsize float64, fontcolor
 
 	gofont, err := truetype.Parse(goregular.TTF)
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("failed to parse font: %v", err)
 	}
 
 	d := &font.Drawer{
@@ -251,14 +396,7 @@ func (glpeer *GLPeer) MakeTextureByText(text string, fontsize float64, fontcolor
 		Y: fixed.I(int(fontsize * dpi / 72)),
 	}
 	d.DrawString(text)
-
-	t, err := glpeer.eng.LoadTexture(img)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	simlog.FuncOut()
-	return sprite.SubTex{T: t, R: rect}
+	return img, nil
 }
 
 // Finalize finalizes GLPeer.
@@ -277,6 +415,43 @@ func (glpeer *GLPeer) Finalize() {
 	simlog.FuncOut()
 }
 
+// Suspend releases GL resources since GL context is going to be lost.
+// Sprites and pixels of textures are kept to be restored by Resume.
+func (glpeer *GLPeer) Suspend() {
+	simlog.FuncIn()
+
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+
+	for _, rt := range glpeer.targets {
+		glpeer.releaseFramebuffer(rt)
+	}
+	glpeer.eng.suspend()
+	glpeer.fps.Release()
+	glpeer.images.Release()
+	glpeer.glc.glcontext = nil
+
+	simlog.FuncOut()
+}
+
+// Resume restores GL resources released by Suspend with new GL context.
+// Textures are uploaded again from their pixels.
+func (glpeer *GLPeer) Resume(glc *GLContext) error {
+	simlog.FuncIn()
+
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+	glpeer.glc = glc
+
+	glctx := glc.glcontext
+	glpeer.images = glutil.NewImages(glctx)
+	glpeer.fps = debug.NewFPS(glpeer.images)
+	err := glpeer.eng.restore(glctx)
+
+	simlog.FuncOut()
+	return err
+}
+
 // Update updates screen.
 // This is called 60 times per 1 sec.
 func (glpeer *GLPeer) Update(sc SpriteContainerer) {
@@ -287,21 +462,43 @@ func (glpeer *GLPeer) Update(sc SpriteContainerer) {
 	if glctx == nil {
 		return
 	}
+	glpeer.releaseDiscarded()
 	glctx.ClearColor(0, 0, 0, 1) // black background
 	glctx.Clear(gl.COLOR_BUFFER_BIT)
 	now := clock.Time(time.Since(glpeer.startTime) * 60 / time.Second)
 
-	glpeer.apply(sc)
-
-	if glpeer.zindexDirty {
-		sort.Sort(glpeer.znodes)
-		glpeer.zindexDirty = false
-		simlog.Debug("nodes sorted by zindex!")
-	}
-	for _, zn := range glpeer.znodes {
-		glpeer.eng.Render(zn.Node, now, screensize.sz)
+	glpeer.arrange()
+	glpeer.renderTargets(now)
+	var stats RenderStats
+	glpeer.eng.drawn = 0
+	glpeer.eng.drawCalls = 0
+	// stencil buffer of the screen is undefined after publishing
+	glpeer.eng.stencilMask = nil
+	for i, vp := range glpeer.getScene().activeViewports() {
+		glpeer.apply(sc, vp, i)
+		// arrangers are called only once per frame
+		glpeer.eng.skipArrange = i > 0
+		for _, znodes := range glpeer.layerNodes {
+			for _, zn := range znodes {
+				if zn.culled {
+					stats.Culled++
+					glpeer.eng.Arrange(zn.Node, now)
+					continue
+				}
+				glpeer.eng.Render(zn.Node, now, screensize.sz)
+			}
+		}
+		glpeer.eng.flush()
 	}
+	stats.Drawn = glpeer.eng.drawn
+	stats.DrawCalls = glpeer.eng.drawCalls
+	glpeer.stats = stats
+	glpeer.eng.skipArrange = false
+	glctx.Disable(gl.SCISSOR_TEST)
 	if config.DEBUG {
+		// glutil.Images expects this blend function
+		glctx.Enable(gl.BLEND)
+		glctx.BlendFunc(gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA)
 		glpeer.fps.Draw(screensize.sz)
 	}
 
@@ -310,24 +507,120 @@ func (glpeer *GLPeer) Update(sc SpriteContainerer) {
 	glpeer.glc.publish()
 }
 
-// ZIndexDirty enables dirty flag. It indicates sorting of znodes is necessary
-// because at least one of their zindex has been updated.
-func (glpeer *GLPeer) ZIndexDirty() {
-	glpeer.zindexDirty = true
+// ZIndexDirty marks the layer of specified node dirty. It indicates sorting of
+// the layer is necessary because zindex of the node has been updated.
+func (glpeer *GLPeer) ZIndexDirty(zn *ZNode) {
+	if glpeer.dirtyLayers == nil {
+		glpeer.dirtyLayers = make(map[int]bool)
+	}
+	glpeer.dirtyLayers[glpeer.layerIndexOf(zn)] = true
+}
+
+// layerIndexOf returns index of the layer that the node belongs to
+func (glpeer *GLPeer) layerIndexOf(zn *ZNode) int {
+	if zn.sprite == nil {
+		return glpeer.layerOrder.def
+	}
+	return glpeer.layerOrder.index(zn.sprite.layer)
+}
+
+// arrange groups nodes by layers and sorts them in the order of drawing.
+// Only the layers that need sorting are sorted.
+func (glpeer *GLPeer) arrange() {
+	sc := glpeer.getScene()
+	if v := sc.getVersion(); v != glpeer.layerVersion {
+		glpeer.layerVersion = v
+		glpeer.layoutDirty = true
+	}
+	if glpeer.layoutDirty {
+		glpeer.layerList = sc.sortedLayers()
+		glpeer.layerOrder = newLayerOrder(glpeer.layerList)
+		glpeer.layerNodes = make([]ZNodes, len(glpeer.layerList))
+		glpeer.nodeIndex = make(map[*Sprite]*ZNode, len(glpeer.znodes))
+		// nodes are grouped in added order
+		for i, zn := range glpeer.znodes {
+			zn.order = i
+			if zn.sprite != nil {
+				glpeer.nodeIndex[zn.sprite] = zn
+			}
+			li := glpeer.layerIndexOf(zn)
+			glpeer.layerNodes[li] = append(glpeer.layerNodes[li], zn)
+		}
+		for i := range glpeer.layerList {
+			glpeer.sortLayer(i)
+		}
+		glpeer.layoutDirty = false
+		glpeer.dirtyLayers = nil
+		return
+	}
+	for i, l := range glpeer.layerList {
+		if glpeer.dirtyLayers[i] || l.sortMode == SortByY {
+			glpeer.sortLayer(i)
+		}
+	}
+	glpeer.dirtyLayers = nil
+}
+
+// sortLayer sorts nodes of i-th layer by its sort mode.
+// Nodes that have the same key keep added order.
+func (glpeer *GLPeer) sortLayer(i int) {
+	znodes := glpeer.layerNodes[i]
+	switch glpeer.layerList[i].sortMode {
+	case SortByZIndex:
+		sort.Slice(znodes, func(a, b int) bool {
+			if znodes[a].ZIndex != znodes[b].ZIndex {
+				// bigger zindex goes far side
+				return znodes[a].ZIndex > znodes[b].ZIndex
+			}
+			return znodes[a].order < znodes[b].order
+		})
+	case SortByY:
+		// y is computed once per frame, not in every comparison
+		for _, zn := range znodes {
+			zn.sortY = zn.y()
+		}
+		// greater y goes far side
+		sort.Slice(znodes, func(a, b int) bool {
+			if znodes[a].sortY != znodes[b].sortY {
+				return znodes[a].sortY > znodes[b].sortY
+			}
+			return znodes[a].order < znodes[b].order
+		})
+	}
+}
+
+// y returns y position of the node in world coordinates
+func (zn *ZNode) y() float32 {
+	if zn.sprite == nil {
+		return 0
+	}
+	_, y := zn.sprite.LocalToWorld(0, 0)
+	return y
+}
+
+// applyTrim makes m that maps the unit square to the sprite map it to the
+// area where trimmed texture of the node is drawn, turning rotated texture.
+// Nine-sliced or repeated texture is drawn untrimmed, and its pieces are
+// turned instead.
+func (zn *ZNode) applyTrim(m *f32.Affine) {
+	if zn.trim != nil && (zn.sprite == nil || zn.sprite.nineSlice == nil && zn.sprite.wrap == nil) {
+		m.Mul(m, zn.trim)
+	}
 }
 
 // Reset resets current gl context.
 // All sprites are also cleaned.
 // This is called at changing of scene, and
 // this function is for clean previous scene.
-func (glpeer *GLPeer) Reset() {
+func (glpeer *GLPeer) Reset() error {
 	simlog.FuncIn()
 
 	glpeer.mu.Lock()
 	defer glpeer.mu.Unlock()
-	glpeer.initEng()
+	err := glpeer.initEng()
 
 	simlog.FuncOut()
+	return err
 }
 
 // SetSubTex registers subtexture to specified node
@@ -335,42 +628,260 @@ func (glpeer *GLPeer) SetSubTex(zn *ZNode, subTex *sprite.SubTex) {
 	glpeer.eng.SetSubTex(zn.Node, *subTex)
 }
 
-func (glpeer *GLPeer) apply(sc SpriteContainerer) {
-	snpairs := sc.GetSpriteNodePairs()
-	snpairs.Range(func(k, v interface{}) bool {
-		sn := v.(*spriteNodePair)
+// apply sets transform and color of nodes to render sprites in specified viewport.
+// vi is index of the viewport in the frame. Transforms are recomputed only for
+// the sprites that moved since the last frame, and nodes out of the visible
+// area are marked as culled, skipping the transform if its bounding circle is
+// already out.
+func (glpeer *GLPeer) apply(sc SpriteContainerer, vp *Viewport, vi int) {
+	// views map coordinates of world and screen space to the screen in pt
+	screen := screensize.screenTransform()
+	camera := vp.camera.view()
+	var world f32.Affine
+	world.Mul(&screen, &camera)
+	viewOf := func(s *Sprite) *f32.Affine {
+		if s.IsScreenSpace() {
+			return &screen
+		}
+		return &world
+	}
+	maskView := func(mask *Sprite) f32.Affine {
+		return *viewOf(mask)
+	}
+
+	for _, sn := range sc.GetSpriteNodePairs() {
 		if sn.sprite == nil || !sn.inuse {
-			return true
+			continue
 		}
 		s := sn.sprite
+		zn := sn.znode
+		n := zn.Node
+
+		// sprite is clipped by its clip rectangle and viewport
+		clip, clipped := s.clipRect()
+		if vp != glpeer.getScene().defaultViewport {
+			r := clipRect{vp.x, vp.y, vp.w, vp.h}
+			if clipped {
+				r = r.intersect(clip)
+			}
+			clip, clipped = r, true
+		}
+		visible := visibleBounds(&screen, clip, clipped)
+		// nodes without texture are not culled since they draw nothing
+		cullable := n.EngineFields.SubTex.T != nil
+
+		view := viewOf(s)
+		c := &zn.transform
+		c.sync(s)
+		vt := c.at(vi, view)
+		if vt == nil {
+			if cullable && !circleBounds(s, view).overlaps(visible) {
+				zn.culled = true
+				continue
+			}
+			if !c.localValid {
+				c.local = s.transform()
+				zn.applyTrim(&c.local)
+				c.localValid = true
+			}
+			var affine f32.Affine
+			affine.Mul(view, &c.local)
+			vt = c.store(vi, view, &affine)
+		}
+		zn.culled = cullable && !vt.bounds.overlaps(visible)
+		if zn.culled {
+			continue
+		}
+		if c.applied != vi+1 {
+			glpeer.eng.SetTransform(n, vt.affine)
+			c.applied = vi + 1
+		}
 
-		affine := &f32.Affine{
-			{1, 0, 0},
-			{0, 1, 0},
+		alpha := s.worldAlpha()
+		if !s.IsVisible() || !vp.shows(s) {
+			alpha = 0
 		}
-		affine.Translate(affine,
-			s.X*screensize.scale-s.W/2*screensize.scale+screensize.marginWidth/2,
-			(screensize.height-s.Y)*screensize.scale-s.H/2*screensize.scale+screensize.marginHeight/2)
-		if s.R != 0 {
-			affine.Translate(affine,
-				0.5*s.W*screensize.scale,
-				0.5*s.H*screensize.scale)
-			affine.Rotate(affine, s.R)
-			affine.Translate(affine,
-				-0.5*s.W*screensize.scale,
-				-0.5*s.H*screensize.scale)
+		in := stateInputs{
+			tint:      s.GetTint(),
+			alpha:     alpha,
+			blend:     s.blend,
+			material:  s.material,
+			clipped:   clipped,
+			sheet:     n.EngineFields.SubTex.R,
+			rotated:   zn.rotated,
+			nineSlice: s.nineSlice,
+			wrap:      s.wrap,
+			w:         s.W,
+			h:         s.H,
 		}
-		affine.Scale(affine,
-			s.W*screensize.scale,
-			s.H*screensize.scale)
-		glpeer.eng.SetTransform(sn.znode.Node, *affine)
-		return true
-	})
+		if clipped {
+			in.scissor[0], in.scissor[1], in.scissor[2], in.scissor[3] = screensize.scissorRect(clip.x, clip.y, clip.w, clip.h)
+		}
+		if zn.state.update(&in) {
+			glpeer.eng.SetColor(n, in.tint, in.alpha)
+			glpeer.eng.SetBlendMode(n, in.blend)
+			glpeer.eng.SetMaterial(n, in.material)
+			glpeer.eng.SetPieces(n, zn.texturePieces())
+			glpeer.eng.SetClip(n, in.scissor, in.clipped)
+		}
+		if mask := glpeer.maskOf(s, maskView); zn.state.updateMask(mask) {
+			glpeer.eng.SetMask(n, mask)
+		}
+	}
+}
+
+// stateInputs are inputs of rendering state of a node other than its transform
+type stateInputs struct {
+	tint     color.RGBA
+	alpha    float32
+	blend    BlendMode
+	material *Material
+	scissor  [4]int32
+	clipped  bool
+	// pieces of the node are made from them
+	sheet     image.Rectangle
+	rotated   bool
+	nineSlice *NineSlice
+	wrap      *TextureWrap
+	w, h      float32
+}
+
+// stateCache remembers rendering state last set to a node, to skip setting
+// it again while it doesn't change.
+type stateCache struct {
+	inputs stateInputs
+	valid  bool
+	// mask is the mask set to the node, if maskValid
+	mask      *nodeMask
+	maskValid bool
+}
+
+// update remembers in and returns true if the state should be set to the node
+func (c *stateCache) update(in *stateInputs) bool {
+	if c.valid && c.inputs == *in {
+		return false
+	}
+	c.inputs = *in
+	c.valid = true
+	return true
+}
+
+// updateMask remembers mask and returns true if it should be set to the node
+func (c *stateCache) updateMask(mask *nodeMask) bool {
+	if c.maskValid && (c.mask == mask || c.mask != nil && mask != nil && *c.mask == *mask) {
+		return false
+	}
+	c.mask = mask
+	c.maskValid = true
+	return true
+}
+
+// invalidate makes all state be set to the node again
+func (c *stateCache) invalidate() {
+	c.valid = false
+	c.maskValid = false
 }
 
 // Texture represents a texture object that contains subTex
 type Texture struct {
 	subTex sprite.SubTex
+	// source is the area of untrimmed image in the texture, that may exceed
+	// the image. empty if the texture is not trimmed.
+	// Area of rotated texture is placed as if it is not rotated.
+	source image.Rectangle
+	// rotated is true if the image is stored in subTex.R rotated by 90
+	// degrees clockwise, as rotated frames of atlas
+	rotated bool
+	// entry is the shared texture loaded from an asset. nil if not shared.
+	entry *textureEntry
+	// released is true if the texture is already released
+	released bool
+}
+
+// Size returns size of the texture in pixels.
+// Trimmed texture has the size of its untrimmed image.
+func (t *Texture) Size() (w, h int) {
+	if !t.source.Empty() {
+		return t.source.Dx(), t.source.Dy()
+	}
+	r := t.frame()
+	return r.Dx(), r.Dy()
+}
+
+// frame returns area of the image in the texture. Rotated image is placed
+// at the same position as if it is not rotated.
+func (t *Texture) frame() image.Rectangle {
+	r := t.subTex.R
+	if t.rotated {
+		return image.Rectangle{Min: r.Min, Max: r.Min.Add(image.Pt(r.Dy(), r.Dx()))}
+	}
+	return r
+}
+
+// rotateAffine maps the unit square of rotated area in a sheet to the
+// unit square of the image
+var rotateAffine = f32.Affine{
+	{0, 1, 0},
+	{-1, 0, 1},
+}
+
+// unrotateAffine is the inverse of rotateAffine
+var unrotateAffine = f32.Affine{
+	{0, -1, 1},
+	{1, 0, 0},
+}
+
+// trim returns an affine that maps the unit square of the texture to the
+// area of trimmed image in the untrimmed image. nil if the texture is not
+// trimmed nor rotated.
+func (t *Texture) trim() *f32.Affine {
+	if t.source.Empty() && !t.rotated {
+		return nil
+	}
+	m := identityAffine
+	if !t.source.Empty() {
+		r, src := t.frame(), t.source
+		w, h := float32(src.Dx()), float32(src.Dy())
+		m = f32.Affine{
+			{float32(r.Dx()) / w, 0, float32(r.Min.X-src.Min.X) / w},
+			{0, float32(r.Dy()) / h, float32(r.Min.Y-src.Min.Y) / h},
+		}
+	}
+	if t.rotated {
+		var rm f32.Affine
+		rm.Mul(&m, &rotateAffine)
+		m = rm
+	}
+	return &m
+}
+
+// AlphaMask returns a HitShape that hits opaque pixels of the texture.
+// Pixels of the texture are kept on CPU for hit test.
+// This returns nil if the texture has no pixels.
+func (t *Texture) AlphaMask(threshold uint8) HitShape {
+	tex, ok := t.subTex.T.(*texture)
+	if !ok {
+		return nil
+	}
+	var img image.Image = tex.pixels()
+	frame := t.subTex.R
+	if t.rotated {
+		// rotated image is restored for hit test only
+		r := unrotate(img, frame)
+		img, frame = r, t.frame().Sub(frame.Min).Add(r.Bounds().Min)
+		if !t.source.Empty() {
+			m := NewAlphaMask(img, t.source.Sub(t.subTex.R.Min), threshold)
+			m.frame = frame
+			return m
+		}
+		return NewAlphaMask(img, frame, threshold)
+	}
+	if !t.source.Empty() {
+		m := NewAlphaMask(img, t.source, threshold)
+		m.frame = frame
+		return m
+	}
+	return NewAlphaMask(img, frame, threshold)
 }
 
 // NewTexture returns a new Texture instance
@@ -380,9 +891,40 @@ func (glpeer *GLPeer) NewTexture(s sprite.SubTex) *Texture {
 	}
 }
 
-// ReleaseTexture releases specified texture
+// ReleaseTexture releases specified texture.
+// Texture shared by asset name is released when all of its users are released.
 func (glpeer *GLPeer) ReleaseTexture(t *Texture) {
 	glpeer.mu.Lock()
 	defer glpeer.mu.Unlock()
-	t.subTex.T.Release()
+	glpeer.releaseTexture(t)
+}
+
+func (glpeer *GLPeer) releaseTexture(t *Texture) {
+	if t.released {
+		return
+	}
+	t.released = true
+	if t.entry != nil {
+		glpeer.textures.release(t.entry)
+		return
+	}
+	if t.subTex.T != nil {
+		t.subTex.T.Release()
+	}
+}
+
+// DiscardTexture marks specified texture to be released at the next Update.
+// Unlike ReleaseTexture, this can be called from any goroutine such as finalizers.
+func (glpeer *GLPeer) DiscardTexture(t *Texture) {
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+	glpeer.discarded = append(glpeer.discarded, t)
+}
+
+// releaseDiscarded releases textures marked by DiscardTexture
+func (glpeer *GLPeer) releaseDiscarded() {
+	for _, t := range glpeer.discarded {
+		glpeer.releaseTexture(t)
+	}
+	glpeer.discarded = nil
 }
@@ -40,3 +40,16 @@ func TestRemoveNode(t *testing.T) {
 		t.Errorf("unexpected result. [got] %d [want] %d", len(glpeer.znodes), 0)
 	}
 }
+
+func TestResetFailure(t *testing.T) {
+	glpeer, glctx := newImageTestPeer(t)
+	glpeer.glc = &GLContext{glcontext: glctx}
+	if err := glpeer.Reset(); err != nil {
+		t.Fatalf("unexpected error. err: %s", err.Error())
+	}
+
+	glctx.noProgram = true
+	if err := glpeer.Reset(); err == nil {
+		t.Errorf("error should be returned if engine can't be created")
+	}
+}
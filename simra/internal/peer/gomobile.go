@@ -9,7 +9,9 @@ import (
 	"github.com/pankona/gomo-simra/simra/simlog"
 
 	"golang.org/x/mobile/app"
+	"golang.org/x/mobile/event/key"
 	"golang.org/x/mobile/event/lifecycle"
+	"golang.org/x/mobile/event/mouse"
 	"golang.org/x/mobile/event/paint"
 	"golang.org/x/mobile/event/size"
 	"golang.org/x/mobile/event/touch"
@@ -19,7 +21,8 @@ import (
 // Gomoer represents an interface of gomobile
 type Gomoer interface {
 	// Initialize initializes Gomo.
-	Initialize(onStart func(glc *GLContext), onStop func(), updateCallback func())
+	// onStart returns an error if GL resources can't be prepared.
+	Initialize(onStart func(glc *GLContext) error, onStop func(), updateCallback func())
 	// Start starts gomobile's main loop.
 	// Most of events handled by peer is fired by this function.
 	Start()
@@ -30,7 +33,9 @@ type Gomo struct {
 	app            app.App
 	screensize     ScreenSizer
 	touch          Toucher
-	onStart        func(glc *GLContext)
+	mouse          Mouser
+	key            Keyer
+	onStart        func(glc *GLContext) error
 	onStop         func()
 	updateCallback func()
 }
@@ -40,11 +45,13 @@ func GetGomo() Gomoer {
 	return &Gomo{
 		screensize: GetScreenSizePeer(),
 		touch:      GetTouchPeer(),
+		mouse:      GetMousePeer(),
+		key:        GetKeyPeer(),
 	}
 }
 
 // Initialize initializes Gomo.
-func (g *Gomo) Initialize(onStart func(glc *GLContext), onStop func(), updateCallback func()) {
+func (g *Gomo) Initialize(onStart func(glc *GLContext) error, onStop func(), updateCallback func()) {
 	simlog.FuncIn()
 	g.onStart = onStart
 	g.onStop = onStop
@@ -56,10 +63,15 @@ func (g *Gomo) Initialize(onStart func(glc *GLContext), onStop func(), updateCal
 func (g *Gomo) handleLifeCycle(e lifecycle.Event) {
 	switch e.Crosses(lifecycle.StageVisible) {
 	case lifecycle.CrossOn:
-		g.onStart(&GLContext{
+		err := g.onStart(&GLContext{
 			glcontext: e.DrawContext.(gl.Context),
 			publish:   g.app.Publish,
 		})
+		if err != nil {
+			// nothing can be drawn without GL resources
+			simlog.Errorf("failed to start. err: %s", err.Error())
+			return
+		}
 		g.app.Send(paint.Event{})
 	case lifecycle.CrossOff:
 		g.onStop()
@@ -84,11 +96,67 @@ func (g *Gomo) handlePaint(e paint.Event) {
 func (g *Gomo) handleTouch(e touch.Event) {
 	switch e.Type {
 	case touch.TypeBegin:
-		g.touch.OnTouchBegin(e.X, e.Y)
+		g.touch.OnTouchBegin(int(e.Sequence), e.X, e.Y)
 	case touch.TypeMove:
-		g.touch.OnTouchMove(e.X, e.Y)
+		g.touch.OnTouchMove(int(e.Sequence), e.X, e.Y)
 	case touch.TypeEnd:
-		g.touch.OnTouchEnd(e.X, e.Y)
+		g.touch.OnTouchEnd(int(e.Sequence), e.X, e.Y)
+	}
+}
+
+func toMouseButton(b mouse.Button) MouseButton {
+	switch b {
+	case mouse.ButtonLeft:
+		return MouseButtonLeft
+	case mouse.ButtonMiddle:
+		return MouseButtonMiddle
+	case mouse.ButtonRight:
+		return MouseButtonRight
+	}
+	return MouseButtonNone
+}
+
+// handleMouse forwards mouse event to Mouser.
+// mouse.Event is delivered by the X11 driver of golang.org/x/mobile patched
+// in third_party/mobile. Other drivers notify clicks as touch.Event only.
+func (g *Gomo) handleMouse(e mouse.Event) {
+	if e.Button.IsWheel() {
+		if e.Direction == mouse.DirRelease {
+			// wheel step is notified as DirStep or DirPress.
+			// ignore release to avoid counting the same step twice.
+			return
+		}
+		var dx, dy float32
+		switch e.Button {
+		case mouse.ButtonWheelUp:
+			dy = 1
+		case mouse.ButtonWheelDown:
+			dy = -1
+		case mouse.ButtonWheelLeft:
+			dx = -1
+		case mouse.ButtonWheelRight:
+			dx = 1
+		}
+		g.mouse.OnMouseWheel(e.X, e.Y, dx, dy)
+		return
+	}
+
+	switch e.Direction {
+	case mouse.DirPress:
+		g.mouse.OnMouseDown(e.X, e.Y, toMouseButton(e.Button))
+	case mouse.DirRelease:
+		g.mouse.OnMouseUp(e.X, e.Y, toMouseButton(e.Button))
+	case mouse.DirNone:
+		g.mouse.OnMouseMove(e.X, e.Y)
+	}
+}
+
+func (g *Gomo) handleKey(e key.Event) {
+	switch e.Direction {
+	case key.DirPress:
+		g.key.OnKeyDown(e.Code)
+	case key.DirRelease:
+		g.key.OnKeyUp(e.Code)
 	}
 }
 
@@ -102,6 +170,10 @@ func (g *Gomo) handleEvent(e interface{}) {
 		g.handlePaint(e)
 	case touch.Event:
 		g.handleTouch(e)
+	case mouse.Event:
+		g.handleMouse(e)
+	case key.Event:
+		g.handleKey(e)
 	}
 }
 
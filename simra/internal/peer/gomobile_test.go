@@ -0,0 +1,124 @@
+// +build darwin linux
+
+package peer
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/mobile/app"
+	"golang.org/x/mobile/event/mouse"
+	"golang.org/x/mobile/event/touch"
+)
+
+// mockMouser records mouse events forwarded by Gomo
+type mockMouser struct {
+	Mouser
+	events []string
+}
+
+func (m *mockMouser) OnMouseMove(x, y float32) {
+	m.events = append(m.events, fmt.Sprintf("move %v %v", x, y))
+}
+func (m *mockMouser) OnMouseDown(x, y float32, button MouseButton) {
+	m.events = append(m.events, fmt.Sprintf("down %v %v %v", x, y, button))
+}
+func (m *mockMouser) OnMouseUp(x, y float32, button MouseButton) {
+	m.events = append(m.events, fmt.Sprintf("up %v %v %v", x, y, button))
+}
+func (m *mockMouser) OnMouseWheel(x, y, dx, dy float32) {
+	m.events = append(m.events, fmt.Sprintf("wheel %v %v %v %v", x, y, dx, dy))
+}
+
+// mockToucher records touch events forwarded by Gomo
+type mockToucher struct {
+	Toucher
+	events []string
+}
+
+func (m *mockToucher) OnTouchBegin(id int, x, y float32) {
+	m.events = append(m.events, fmt.Sprintf("begin %v %v %v", id, x, y))
+}
+func (m *mockToucher) OnTouchMove(id int, x, y float32) {
+	m.events = append(m.events, fmt.Sprintf("move %v %v %v", id, x, y))
+}
+func (m *mockToucher) OnTouchEnd(id int, x, y float32) {
+	m.events = append(m.events, fmt.Sprintf("end %v %v %v", id, x, y))
+}
+
+// mockApp is an app.App that passes events through its filter as they are
+type mockApp struct {
+	app.App
+}
+
+func (a *mockApp) Filter(e interface{}) interface{} { return e }
+
+func TestHandleMouse(t *testing.T) {
+	tcs := []struct {
+		e    mouse.Event
+		want string
+	}{
+		{mouse.Event{X: 1, Y: 2}, "move 1 2"},
+		{mouse.Event{X: 1, Y: 2, Button: mouse.ButtonLeft, Direction: mouse.DirPress},
+			fmt.Sprintf("down 1 2 %v", MouseButtonLeft)},
+		{mouse.Event{X: 1, Y: 2, Button: mouse.ButtonRight, Direction: mouse.DirRelease},
+			fmt.Sprintf("up 1 2 %v", MouseButtonRight)},
+		{mouse.Event{X: 1, Y: 2, Button: mouse.ButtonMiddle, Direction: mouse.DirPress},
+			fmt.Sprintf("down 1 2 %v", MouseButtonMiddle)},
+		{mouse.Event{X: 3, Y: 4, Button: mouse.ButtonWheelUp, Direction: mouse.DirStep}, "wheel 3 4 0 1"},
+		{mouse.Event{X: 3, Y: 4, Button: mouse.ButtonWheelDown, Direction: mouse.DirPress}, "wheel 3 4 0 -1"},
+		{mouse.Event{X: 3, Y: 4, Button: mouse.ButtonWheelLeft, Direction: mouse.DirStep}, "wheel 3 4 -1 0"},
+		{mouse.Event{X: 3, Y: 4, Button: mouse.ButtonWheelRight, Direction: mouse.DirStep}, "wheel 3 4 1 0"},
+		// release of wheel is not counted as another step
+		{mouse.Event{X: 3, Y: 4, Button: mouse.ButtonWheelDown, Direction: mouse.DirRelease}, ""},
+	}
+	for i, tc := range tcs {
+		m := &mockMouser{}
+		g := &Gomo{mouse: m}
+		g.handleMouse(tc.e)
+		var got string
+		if len(m.events) > 0 {
+			got = m.events[0]
+		}
+		if len(m.events) > 1 || got != tc.want {
+			t.Errorf("[%d] unexpected result. [got] %v [want] %v", i, m.events, tc.want)
+		}
+	}
+}
+
+// TestHandleX11Events feeds events in the order the X11 driver of
+// third_party/mobile sends them for hovering, a click and a wheel step.
+func TestHandleX11Events(t *testing.T) {
+	m := &mockMouser{}
+	tc := &mockToucher{}
+	g := &Gomo{app: &mockApp{}, mouse: m, touch: tc}
+	events := []interface{}{
+		mouse.Event{X: 1, Y: 2},
+		mouse.Event{X: 1, Y: 2, Button: mouse.ButtonLeft, Direction: mouse.DirPress},
+		touch.Event{X: 1, Y: 2, Type: touch.TypeBegin},
+		mouse.Event{X: 3, Y: 4},
+		touch.Event{X: 3, Y: 4, Type: touch.TypeMove},
+		mouse.Event{X: 3, Y: 4, Button: mouse.ButtonLeft, Direction: mouse.DirRelease},
+		touch.Event{X: 3, Y: 4, Type: touch.TypeEnd},
+		touch.Event{X: 5, Y: 6, Sequence: 1, Type: touch.TypeBegin},
+		mouse.Event{X: 3, Y: 4, Button: mouse.ButtonWheelUp, Direction: mouse.DirStep},
+	}
+	for _, e := range events {
+		g.handleEvent(e)
+	}
+
+	wantMouse := []string{
+		"move 1 2",
+		fmt.Sprintf("down 1 2 %v", MouseButtonLeft),
+		"move 3 4",
+		fmt.Sprintf("up 3 4 %v", MouseButtonLeft),
+		"wheel 3 4 0 1",
+	}
+	if fmt.Sprint(m.events) != fmt.Sprint(wantMouse) {
+		t.Errorf("unexpected result. [got] %v [want] %v", m.events, wantMouse)
+	}
+	wantTouch := []string{"begin 0 1 2", "move 0 3 4", "end 0 3 4", "begin 1 5 6"}
+	if fmt.Sprint(tc.events) != fmt.Sprint(wantTouch) {
+		t.Errorf("unexpected result. [got] %v [want] %v", tc.events, wantTouch)
+	}
+}
@@ -0,0 +1,130 @@
+package peer
+
+import (
+	"errors"
+
+	"github.com/pankona/gomo-simra/simra/simlog"
+)
+
+// AddChild adds specified sprite as a child.
+// Position, rotation, skew and scale factor of the child are relative to
+// the anchor point of this sprite, and alpha and visibility of this sprite
+// are inherited to the child.
+// If the child already has another parent, it is detached from the parent
+// keeping its relative values.
+func (s *Sprite) AddChild(child *Sprite) error {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	for p := s; p != nil; p = p.parent {
+		if p == child {
+			return errors.New("sprite can't be a child of itself or its descendant")
+		}
+	}
+	if child.parent != nil {
+		child.parent.RemoveChild(child)
+	}
+	child.parent = s
+	s.children = append(s.children, child)
+	return nil
+}
+
+// RemoveChild detaches specified child from this sprite.
+// Detached sprite's position becomes relative to virtual screen.
+func (s *Sprite) RemoveChild(child *Sprite) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	if child.parent != s {
+		return
+	}
+	var children []*Sprite
+	for _, c := range s.children {
+		if c != child {
+			children = append(children, c)
+		}
+	}
+	s.children = children
+	child.parent = nil
+}
+
+// GetParent returns parent of this sprite. nil if this sprite is a root.
+func (s *Sprite) GetParent() *Sprite {
+	return s.parent
+}
+
+// GetChildren returns children of this sprite.
+func (s *Sprite) GetChildren() []*Sprite {
+	return s.children
+}
+
+// SetOwner sets the object that wraps sprite, so that the object can be
+// looked up from parent and children of sprite.
+func (s *Sprite) SetOwner(owner interface{}) {
+	s.owner = owner
+}
+
+// GetOwner returns the object that wraps sprite. nil if not set.
+func (s *Sprite) GetOwner() interface{} {
+	return s.owner
+}
+
+// SetScaleFactor sets scale factor of sprite.
+// The factor multiplies size of sprite and is inherited to its children.
+// Default is (1, 1).
+func (s *Sprite) SetScaleFactor(x, y float32) {
+	s.scaleX, s.scaleY = x-1, y-1
+}
+
+// GetScaleFactor returns scale factor of sprite.
+func (s *Sprite) GetScaleFactor() (x, y float32) {
+	return s.scaleX + 1, s.scaleY + 1
+}
+
+// SetVisible sets visibility of sprite.
+// Hidden sprite and its descendants are not rendered, and not hit by touch or hover.
+// They keep their texture, z-index and listeners.
+func (s *Sprite) SetVisible(visible bool) {
+	s.hidden = !visible
+}
+
+// IsVisible returns true if sprite, its layer and all of its ancestors are visible.
+func (s *Sprite) IsVisible() bool {
+	return s.GetLayer().IsVisible() && !s.hiddenInHierarchy()
+}
+
+// hiddenInHierarchy returns true if sprite or any of its ancestors is hidden.
+// Visibility of layer is not considered.
+func (s *Sprite) hiddenInHierarchy() bool {
+	for p := s; p != nil; p = p.parent {
+		if p.hidden {
+			return true
+		}
+	}
+	return false
+}
+
+// worldAlpha returns opacity of sprite multiplied by opacities of all ancestors.
+func (s *Sprite) worldAlpha() float32 {
+	a := s.GetAlpha()
+	for p := s.parent; p != nil; p = p.parent {
+		a *= p.GetAlpha()
+	}
+	return a
+}
+
+// LocalToWorld converts specified position relative to the anchor point
+// of this sprite, which is the coordinates of its children, to world coordinates.
+// For screen-space sprite, world coordinates are virtual screen coordinates.
+func (s *Sprite) LocalToWorld(x, y float32) (float32, float32) {
+	m := s.worldTransform()
+	return m[0][0]*x + m[0][1]*y + m[0][2], m[1][0]*x + m[1][1]*y + m[1][2]
+}
+
+// WorldToLocal converts specified position in world coordinates
+// to the position relative to the anchor point of this sprite.
+func (s *Sprite) WorldToLocal(x, y float32) (float32, float32) {
+	m := s.worldTransform()
+	m.Inverse(&m)
+	return m[0][0]*x + m[0][1]*y + m[0][2], m[1][0]*x + m[1][1]*y + m[1][2]
+}
@@ -0,0 +1,99 @@
+package peer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAddRemoveChild(t *testing.T) {
+	parent := &Sprite{}
+	other := &Sprite{}
+	child := &Sprite{}
+
+	if err := parent.AddChild(child); err != nil {
+		t.Fatalf("failed to add child. err: %s", err.Error())
+	}
+	if child.GetParent() != parent || len(parent.GetChildren()) != 1 {
+		t.Errorf("child should be added to parent")
+	}
+
+	// reparenting
+	if err := other.AddChild(child); err != nil {
+		t.Fatalf("failed to add child. err: %s", err.Error())
+	}
+	if child.GetParent() != other || len(parent.GetChildren()) != 0 || len(other.GetChildren()) != 1 {
+		t.Errorf("child should be moved to other parent")
+	}
+
+	// cycle is not allowed
+	if err := child.AddChild(other); err == nil {
+		t.Errorf("ancestor should not be added as a child")
+	}
+	if err := child.AddChild(child); err == nil {
+		t.Errorf("sprite itself should not be added as a child")
+	}
+
+	other.RemoveChild(child)
+	if child.GetParent() != nil || len(other.GetChildren()) != 0 {
+		t.Errorf("child should be removed")
+	}
+}
+
+func TestWorldTransform(t *testing.T) {
+	parent := &Sprite{X: 100, Y: 100, W: 50, H: 50, R: math.Pi / 2}
+	parent.SetScaleFactor(2, 2)
+	child := &Sprite{X: 10, Y: 0, W: 10, H: 10}
+	if err := parent.AddChild(child); err != nil {
+		t.Fatalf("failed to add child. err: %s", err.Error())
+	}
+
+	// child is rotated and scaled by parent
+	x, y := child.LocalToWorld(0, 0)
+	if !nearlyEqual(x, 100) || !nearlyEqual(y, 120) {
+		t.Errorf("unexpected result. [got] (%f, %f) [want] (%f, %f)", x, y, 100.0, 120.0)
+	}
+	x, y = child.WorldToLocal(100, 120)
+	if !nearlyEqual(x, 0) || !nearlyEqual(y, 0) {
+		t.Errorf("unexpected result. [got] (%f, %f) [want] (%f, %f)", x, y, 0.0, 0.0)
+	}
+
+	// hit testing considers transform of parent. child is 20x20 in world.
+	if !child.Contains(109, 129) || child.Contains(111, 120) {
+		t.Errorf("unexpected result of hit testing for child")
+	}
+}
+
+func TestInheritAlphaAndVisibility(t *testing.T) {
+	parent := &Sprite{}
+	child := &Sprite{}
+	if err := parent.AddChild(child); err != nil {
+		t.Fatalf("failed to add child. err: %s", err.Error())
+	}
+
+	parent.SetAlpha(0.5)
+	child.SetAlpha(0.5)
+	if a := child.worldAlpha(); a != 0.25 {
+		t.Errorf("unexpected result. [got] %f [want] %f", a, 0.25)
+	}
+
+	parent.SetVisible(false)
+	if child.IsVisible() {
+		t.Errorf("child of hidden parent should be hidden")
+	}
+	parent.SetVisible(true)
+	if !child.IsVisible() {
+		t.Errorf("child should be visible")
+	}
+}
+
+func TestHiddenSpriteIsNotHit(t *testing.T) {
+	s := &Sprite{X: 100, Y: 100, W: 50, H: 50}
+	s.SetVisible(false)
+	if s.Contains(100, 100) {
+		t.Errorf("hidden sprite should not be hit")
+	}
+	s.SetVisible(true)
+	if !s.Contains(100, 100) {
+		t.Errorf("visible sprite should be hit")
+	}
+}
@@ -0,0 +1,87 @@
+package peer
+
+import "image"
+
+// HitShape represents a shape of sprite for hit testing.
+// x and y are given in sprite-local coordinates, that is,
+// the origin is the center of sprite and the axes are rotated with sprite.
+// w and h are the size of sprite.
+type HitShape interface {
+	// Contains returns true if specified local position hits the shape.
+	Contains(x, y, w, h float32) bool
+}
+
+// HitShapeFunc is an adapter to use a function as HitShape.
+type HitShapeFunc func(x, y, w, h float32) bool
+
+// Contains calls f(x, y, w, h).
+func (f HitShapeFunc) Contains(x, y, w, h float32) bool {
+	return f(x, y, w, h)
+}
+
+// RectShape is a HitShape that hits whole rectangle of sprite.
+// This is used when no HitShape is specified to sprite.
+type RectShape struct{}
+
+// Contains returns true if specified local position is inside of sprite's rectangle.
+func (RectShape) Contains(x, y, w, h float32) bool {
+	return x >= -w/2 && x <= w/2 && y >= -h/2 && y <= h/2
+}
+
+// EllipseShape is a HitShape of an ellipse inscribed in sprite's rectangle.
+type EllipseShape struct{}
+
+// Contains returns true if specified local position is inside of the ellipse.
+func (EllipseShape) Contains(x, y, w, h float32) bool {
+	if w == 0 || h == 0 {
+		return false
+	}
+	nx := x / (w / 2)
+	ny := y / (h / 2)
+	return nx*nx+ny*ny <= 1
+}
+
+// AlphaMask is a HitShape that hits opaque pixels of an image.
+// It is useful to ignore touches on transparent area of irregular shaped sprites.
+type AlphaMask struct {
+	img       image.Image
+	rect      image.Rectangle
+	threshold uint8
+	// frame is the area of rect where pixels of img are available.
+	// pixels out of it are transparent.
+	frame image.Rectangle
+}
+
+// NewAlphaMask returns an AlphaMask that refers rect of img.
+// A pixel whose alpha is equal to or greater than threshold is regarded as hit.
+func NewAlphaMask(img image.Image, rect image.Rectangle, threshold uint8) *AlphaMask {
+	return &AlphaMask{
+		img:       img,
+		rect:      rect,
+		threshold: threshold,
+		frame:     rect,
+	}
+}
+
+// Contains returns true if the pixel of image at specified local position is opaque.
+func (m *AlphaMask) Contains(x, y, w, h float32) bool {
+	if !(RectShape{}).Contains(x, y, w, h) || w == 0 || h == 0 {
+		return false
+	}
+	// image's y axis goes downward while sprite's one goes upward.
+	u := (x + w/2) / w
+	v := (h/2 - y) / h
+	px := m.rect.Min.X + int(u*float32(m.rect.Dx()))
+	py := m.rect.Min.Y + int(v*float32(m.rect.Dy()))
+	if px >= m.rect.Max.X {
+		px = m.rect.Max.X - 1
+	}
+	if py >= m.rect.Max.Y {
+		py = m.rect.Max.Y - 1
+	}
+	if !image.Pt(px, py).In(m.frame) {
+		return false
+	}
+	_, _, _, a := m.img.At(px, py).RGBA()
+	return uint8(a>>8) >= m.threshold
+}
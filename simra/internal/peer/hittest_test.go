@@ -0,0 +1,105 @@
+package peer
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestRotatedSpriteContains(t *testing.T) {
+	// 100x20 horizontal bar rotated by 90 degrees is a vertical bar
+	s := &Sprite{X: 100, Y: 100, W: 100, H: 20, R: math.Pi / 2}
+
+	tcs := []struct {
+		x, y float32
+		want bool
+	}{
+		{x: 100, y: 100, want: true},
+		{x: 100, y: 145, want: true},
+		{x: 100, y: 55, want: true},
+		{x: 145, y: 100, want: false},
+		{x: 55, y: 100, want: false},
+	}
+	for _, tc := range tcs {
+		if got := s.Contains(tc.x, tc.y); got != tc.want {
+			t.Errorf("unexpected result at (%f, %f). [got] %t [want] %t", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestEllipseShape(t *testing.T) {
+	s := &Sprite{X: 0, Y: 0, W: 100, H: 100}
+	s.SetHitShape(EllipseShape{})
+
+	if !s.Contains(0, 49) {
+		t.Errorf("center top should be contained")
+	}
+	if s.Contains(45, 45) {
+		t.Errorf("corner should not be contained")
+	}
+
+	// nil resets to rectangle
+	s.SetHitShape(nil)
+	if !s.Contains(45, 45) {
+		t.Errorf("corner should be contained")
+	}
+}
+
+func TestHitShapeFunc(t *testing.T) {
+	s := &Sprite{X: 10, Y: 10, W: 20, H: 20}
+	// right half only
+	s.SetHitShape(HitShapeFunc(func(x, y, w, h float32) bool {
+		return x >= 0 && x <= w/2 && y >= -h/2 && y <= h/2
+	}))
+	if !s.Contains(15, 10) {
+		t.Errorf("right half should be contained")
+	}
+	if s.Contains(5, 10) {
+		t.Errorf("left half should not be contained")
+	}
+}
+
+func TestAlphaMask(t *testing.T) {
+	// 4x4 image. left top 2x2 is opaque, others are transparent.
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for x := 0; x < 2; x++ {
+		for y := 0; y < 2; y++ {
+			img.Set(x, y, color.RGBA{255, 255, 255, 255})
+		}
+	}
+
+	tex := &Texture{}
+	tex.subTex.T = &texture{rgba: img, b: img.Bounds(), width: 4, height: 4}
+	tex.subTex.R = image.Rect(0, 0, 4, 4)
+
+	s := &Sprite{X: 0, Y: 0, W: 40, H: 40}
+	s.SetHitShape(tex.AlphaMask(128))
+
+	tcs := []struct {
+		x, y float32
+		want bool
+	}{
+		{x: -10, y: 10, want: true},   // left top
+		{x: 10, y: 10, want: false},   // right top
+		{x: -10, y: -10, want: false}, // left bottom
+		{x: 10, y: -10, want: false},  // right bottom
+		{x: -30, y: 10, want: false},  // out of sprite
+	}
+	for _, tc := range tcs {
+		if got := s.Contains(tc.x, tc.y); got != tc.want {
+			t.Errorf("unexpected result at (%f, %f). [got] %t [want] %t", tc.x, tc.y, got, tc.want)
+		}
+	}
+
+	// sub rectangle of image
+	tex.subTex.R = image.Rect(1, 1, 3, 3)
+	s.SetHitShape(tex.AlphaMask(128))
+	if !s.Contains(-10, 10) || s.Contains(10, -10) {
+		t.Errorf("unexpected result for sub rectangle")
+	}
+
+	if (&Texture{}).AlphaMask(128) != nil {
+		t.Errorf("texture without image should return nil")
+	}
+}
@@ -0,0 +1,88 @@
+package peer
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif" // decoded by NewTextureFromBytes in addition to png and jpeg
+
+	"github.com/pankona/gomo-simra/simra/simlog"
+	"golang.org/x/mobile/exp/sprite"
+)
+
+// NewTextureFromImage returns a texture that has pixels of specified image.
+// Changes of the image are not reflected to the texture. The image is referred
+// to restore the texture after GL context is lost, so it must not be modified.
+func (glpeer *GLPeer) NewTextureFromImage(img image.Image) *Texture {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+	return glpeer.newTextureFromImage(img, reloadImage(img), "image")
+}
+
+// NewTextureFromBytes returns a texture of image encoded in specified bytes.
+// Format of the image is detected from its content. png, jpeg and gif are supported.
+// The bytes are referred to restore the texture after GL context is lost,
+// so they must not be modified.
+func (glpeer *GLPeer) NewTextureFromBytes(b []byte) (*Texture, error) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	img, format, err := image.Decode(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %v", err)
+	}
+
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+	return glpeer.newTextureFromImage(img, func() (image.Image, error) {
+		img, _, err := image.Decode(bytes.NewReader(b))
+		return img, err
+	}, "bytes:"+format), nil
+}
+
+// newTextureFromImage loads specified image as a texture whose sub rectangle
+// is whole of the image. reload returns the image again to restore the texture.
+func (glpeer *GLPeer) newTextureFromImage(img image.Image, reload func() (image.Image, error), source string) *Texture {
+	t, err := glpeer.eng.loadTexture(img, reload, source)
+	if err != nil {
+		simlog.Error(err)
+	}
+	b := img.Bounds()
+	return &Texture{
+		subTex: sprite.SubTex{T: t, R: image.Rect(0, 0, b.Dx(), b.Dy())},
+	}
+}
+
+// UpdateTexture replaces pixels of the texture with src, placing top left
+// of src at (x, y) of the texture. Pixels out of the texture are ignored.
+// Textures that share pixels, such as textures of the same asset, are also updated.
+func (glpeer *GLPeer) UpdateTexture(t *Texture, x, y int, src image.Image) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+
+	tex, ok := t.subTex.T.(*texture)
+	if !ok || t.released {
+		return
+	}
+	if t.rotated {
+		// src is turned as the image is in the sheet
+		src = rotate(src)
+		x, y = t.subTex.R.Dx()-y-src.Bounds().Dx(), x
+	}
+	sb := src.Bounds()
+	min := t.subTex.R.Min.Add(image.Pt(x, y))
+	r := image.Rectangle{Min: min, Max: min.Add(sb.Size())}.Intersect(t.subTex.R)
+	if r.Empty() {
+		return
+	}
+	// updated pixels can not be reproduced by the source of the texture
+	tex.pixels()
+	tex.reload = nil
+	tex.draw(r, src, sb.Min.Add(r.Min.Sub(min)))
+}
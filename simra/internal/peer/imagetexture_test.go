@@ -0,0 +1,135 @@
+package peer
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"golang.org/x/mobile/gl"
+)
+
+// TexSubImage2D replaces rows of bound texture
+func (m *mockObjectGLContext) TexSubImage2D(target gl.Enum, level int, x, y, width, height int, format, ty gl.Enum, data []byte) {
+	stride := width * 4
+	copy(m.textures[m.bound][y*stride:], data)
+}
+
+func newImageTestPeer(t *testing.T) (*GLPeer, *mockObjectGLContext) {
+	glctx := newMockObjectGLContext()
+	e, err := newEngine(glctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &GLPeer{eng: e}, glctx
+}
+
+func TestNewTextureFromImage(t *testing.T) {
+	glpeer, _ := newImageTestPeer(t)
+	red := color.RGBA{R: 255, A: 255}
+	// image whose origin is not zero
+	img := image.NewRGBA(image.Rect(10, 10, 13, 12))
+	img.Set(10, 10, red)
+
+	tex := glpeer.NewTextureFromImage(img)
+	if want := image.Rect(0, 0, 3, 2); tex.subTex.R != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", tex.subTex.R, want)
+	}
+	if got := tex.subTex.T.(*texture).pixels().RGBAAt(0, 0); got != red {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, red)
+	}
+	// the image is copied
+	img.Set(11, 10, red)
+	if got := tex.subTex.T.(*texture).pixels().RGBAAt(1, 0); got == red {
+		t.Errorf("change of the image should not be reflected")
+	}
+}
+
+func TestNewTextureFromBytes(t *testing.T) {
+	glpeer, _ := newImageTestPeer(t)
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	img.Set(1, 2, color.NRGBA{G: 255, A: 128})
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+
+	tex, err := glpeer.NewTextureFromBytes(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// pixels are premultiplied
+	want := color.RGBA{G: 128, A: 128}
+	if got := tex.subTex.T.(*texture).pixels().RGBAAt(1, 2); got != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+	if got := tex.subTex.T.(*texture).source; got != "bytes:png" {
+		t.Errorf("unexpected result. [got] %s [want] %s", got, "bytes:png")
+	}
+
+	if _, err := glpeer.NewTextureFromBytes([]byte("not an image")); err == nil {
+		t.Errorf("error should be returned for unknown format")
+	}
+}
+
+func TestUpdateTexture(t *testing.T) {
+	glpeer, glctx := newImageTestPeer(t)
+	tex := glpeer.NewTextureFromImage(image.NewRGBA(image.Rect(0, 0, 4, 4)))
+	red := color.RGBA{R: 255, A: 255}
+	src := image.NewRGBA(image.Rect(5, 5, 8, 8))
+	for y := 5; y < 8; y++ {
+		for x := 5; x < 8; x++ {
+			src.Set(x, y, red)
+		}
+	}
+
+	// src is placed at (-1, 2) and clipped by the texture
+	glpeer.UpdateTexture(tex, -1, 2, src)
+	rgba := tex.subTex.T.(*texture).rgba
+	gltex := tex.subTex.T.(*texture).gltex
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			want := color.RGBA{}
+			if x < 2 && y >= 2 {
+				want = red
+			}
+			if got := rgba.RGBAAt(x, y); got != want {
+				t.Errorf("(%d, %d) unexpected result. [got] %v [want] %v", x, y, got, want)
+			}
+		}
+	}
+	if got := glctx.textures[gltex.Value]; string(got) != string(rgba.Pix) {
+		t.Errorf("pixels should be uploaded. [got] %v [want] %v", got, rgba.Pix)
+	}
+
+	// released texture is not updated
+	glpeer.ReleaseTexture(tex)
+	glpeer.UpdateTexture(tex, 0, 0, src)
+	if got := rgba.RGBAAt(3, 0); got != (color.RGBA{}) {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, color.RGBA{})
+	}
+}
+
+func TestUpdateRotatedTexture(t *testing.T) {
+	glpeer, _ := newImageTestPeer(t)
+	// 4 x 6 image rotated clockwise in 6 x 4 area
+	tex := glpeer.NewTextureFromImage(image.NewRGBA(image.Rect(0, 0, 6, 4)))
+	tex.rotated = true
+	red := color.RGBA{R: 255, A: 255}
+	src := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	src.Set(0, 0, red)
+
+	// top left of the image is at top right of the area
+	glpeer.UpdateTexture(tex, 0, 0, src)
+	rgba := tex.subTex.T.(*texture).rgba
+	if got := rgba.RGBAAt(5, 0); got != red {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, red)
+	}
+	if got := rgba.RGBAAt(5, 1); got != (color.RGBA{}) {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, color.RGBA{})
+	}
+	if r := unrotate(rotate(src), image.Rect(0, 0, 1, 2)); r.RGBAAt(0, 0) != red || r.Bounds() != src.Bounds() {
+		t.Errorf("unrotate should restore rotated image")
+	}
+}
@@ -0,0 +1,92 @@
+package peer
+
+import (
+	"github.com/pankona/gomo-simra/simra/simlog"
+	"golang.org/x/mobile/event/key"
+)
+
+// KeyListener is interface to be notified key event.
+type KeyListener interface {
+	// OnKeyDown is called when a key is pressed.
+	OnKeyDown(code key.Code)
+	// OnKeyUp is called when a key is released.
+	OnKeyUp(code key.Code)
+}
+
+// Keyer represents an interface for keyboard controller
+type Keyer interface {
+	// AddKeyListener registers a listener to notify key event.
+	AddKeyListener(listener KeyListener)
+	// RemoveKeyListener removes specified listener.
+	RemoveKeyListener(listener KeyListener)
+	// RemoveAllKeyListeners removes all registered listeners.
+	RemoveAllKeyListeners()
+	// OnKeyDown is called when a key is pressed.
+	// This event is notified to all registered listeners.
+	OnKeyDown(code key.Code)
+	// OnKeyUp is called when a key is released.
+	// This event is notified to all registered listeners.
+	OnKeyUp(code key.Code)
+}
+
+// KeyPeer represents a Key object.
+// Singleton.
+type KeyPeer struct {
+	keyListeners []KeyListener
+}
+
+var keyPeer = &KeyPeer{}
+
+// GetKeyPeer returns instance of KeyPeer.
+// Since KeyPeer is singleton, it is necessary to
+// call this function to get instance of KeyPeer.
+func GetKeyPeer() Keyer {
+	return keyPeer
+}
+
+// AddKeyListener registers a listener to notify key event.
+func (kp *KeyPeer) AddKeyListener(listener KeyListener) {
+	simlog.FuncIn()
+	kp.keyListeners = append(kp.keyListeners, listener)
+	simlog.FuncOut()
+}
+
+// RemoveKeyListener removes specified listener.
+func (kp *KeyPeer) RemoveKeyListener(listener KeyListener) {
+	simlog.FuncIn()
+	result := []KeyListener{}
+	for _, l := range kp.keyListeners {
+		if l != listener {
+			result = append(result, l)
+		}
+	}
+	kp.keyListeners = result
+	simlog.FuncOut()
+}
+
+// RemoveAllKeyListeners removes all registered listeners.
+func (kp *KeyPeer) RemoveAllKeyListeners() {
+	simlog.FuncIn()
+	kp.keyListeners = nil
+	simlog.FuncOut()
+}
+
+// OnKeyDown is called when a key is pressed.
+// This event is notified to all registered listeners.
+func (kp *KeyPeer) OnKeyDown(code key.Code) {
+	simlog.FuncIn()
+	for i := range kp.keyListeners {
+		kp.keyListeners[i].OnKeyDown(code)
+	}
+	simlog.FuncOut()
+}
+
+// OnKeyUp is called when a key is released.
+// This event is notified to all registered listeners.
+func (kp *KeyPeer) OnKeyUp(code key.Code) {
+	simlog.FuncIn()
+	for i := range kp.keyListeners {
+		kp.keyListeners[i].OnKeyUp(code)
+	}
+	simlog.FuncOut()
+}
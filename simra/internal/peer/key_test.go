@@ -0,0 +1,66 @@
+package peer
+
+import (
+	"testing"
+
+	"golang.org/x/mobile/event/key"
+)
+
+type keyListener struct {
+	down []key.Code
+	up   []key.Code
+}
+
+func (l *keyListener) OnKeyDown(code key.Code) {
+	l.down = append(l.down, code)
+}
+
+func (l *keyListener) OnKeyUp(code key.Code) {
+	l.up = append(l.up, code)
+}
+
+func TestGetKeyPeer(t *testing.T) {
+	k1 := GetKeyPeer()
+	k2 := GetKeyPeer()
+
+	if k1 != k2 {
+		t.Error("unexpected result .GetKeyPeer should return same address")
+	}
+}
+
+func TestAddRemoveKeyListener(t *testing.T) {
+	kp := &KeyPeer{}
+	listeners := make([]*keyListener, 10)
+	for i := range listeners {
+		listeners[i] = &keyListener{}
+		kp.AddKeyListener(listeners[i])
+		if len(kp.keyListeners) != i+1 {
+			t.Errorf("unexpected result. [got] %d [want] %d", len(kp.keyListeners), i+1)
+		}
+	}
+
+	kp.RemoveKeyListener(listeners[3])
+	if len(kp.keyListeners) != 9 {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(kp.keyListeners), 9)
+	}
+
+	kp.RemoveAllKeyListeners()
+	if len(kp.keyListeners) != 0 {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(kp.keyListeners), 0)
+	}
+}
+
+func TestKeyEvents(t *testing.T) {
+	kp := &KeyPeer{}
+	l := &keyListener{}
+	kp.AddKeyListener(l)
+	kp.OnKeyDown(key.CodeSpacebar)
+	kp.OnKeyUp(key.CodeSpacebar)
+
+	if len(l.down) != 1 || l.down[0] != key.CodeSpacebar {
+		t.Errorf("unexpected result. [got] %v [want] %v", l.down, []key.Code{key.CodeSpacebar})
+	}
+	if len(l.up) != 1 || l.up[0] != key.CodeSpacebar {
+		t.Errorf("unexpected result. [got] %v [want] %v", l.up, []key.Code{key.CodeSpacebar})
+	}
+}
@@ -0,0 +1,200 @@
+package peer
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SortMode represents how sprites in a layer are sorted for rendering.
+type SortMode int
+
+const (
+	// SortByZIndex sorts sprites by zindex. Sprite that has lesser zindex is in front.
+	// Sprites that have the same zindex are drawn in added order.
+	SortByZIndex SortMode = iota
+	// SortByY sorts sprites by y position every frame. Sprite that has lesser y
+	// is in front. This is useful for top-down games.
+	SortByY
+	// SortByInsertion draws sprites in added order. zindex is ignored.
+	SortByInsertion
+)
+
+// DefaultLayerName is name of the layer that sprites belong to by default.
+const DefaultLayerName = "default"
+
+// Layer represents a named group of sprites that are rendered together.
+// Layers are rendered in ascending order, so that a layer that has greater
+// order is drawn over the others.
+type Layer struct {
+	name     string
+	order    int
+	sortMode SortMode
+	hidden   bool
+	// scene is the scene that the layer is added to
+	scene *scene
+}
+
+// GetName returns name of the layer.
+func (l *Layer) GetName() string {
+	return l.name
+}
+
+// GetOrder returns order of the layer.
+func (l *Layer) GetOrder() int {
+	return l.order
+}
+
+// SetSortMode sets how sprites in the layer are sorted.
+func (l *Layer) SetSortMode(mode SortMode) {
+	if l.sortMode != mode {
+		l.sortMode = mode
+		l.scene.touch()
+	}
+}
+
+// GetSortMode returns how sprites in the layer are sorted.
+func (l *Layer) GetSortMode() SortMode {
+	return l.sortMode
+}
+
+// SetVisible sets visibility of the layer.
+// Sprites in hidden layer are not rendered, and not hit by touch or hover.
+func (l *Layer) SetVisible(visible bool) {
+	l.hidden = !visible
+}
+
+// IsVisible returns true if the layer is visible.
+func (l *Layer) IsVisible() bool {
+	return !l.hidden
+}
+
+func (sc *scene) touch() {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.version++
+}
+
+func (sc *scene) getVersion() int {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	return sc.version
+}
+
+// getDefaultLayer returns the default layer of the scene
+func (sc *scene) getDefaultLayer() *Layer {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	return sc.defaultLayer
+}
+
+// sortedLayers returns layers from back to front
+func (sc *scene) sortedLayers() []*Layer {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	list := make([]*Layer, len(sc.layers))
+	copy(list, sc.layers)
+	return list
+}
+
+// AddLayer adds a layer that has specified name and order.
+// Layer that has greater order is drawn over the others. The default layer's order is 0.
+func (glpeer *GLPeer) AddLayer(name string, order int) (*Layer, error) {
+	sc := glpeer.getScene()
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	for _, l := range sc.layers {
+		if l.name == name {
+			return nil, fmt.Errorf("layer %q already exists", name)
+		}
+	}
+	l := &Layer{name: name, order: order, scene: sc}
+	sc.layers = append(sc.layers, l)
+	// keep the order of adding for the layers that have same order
+	sort.SliceStable(sc.layers, func(i, j int) bool {
+		return sc.layers[i].order < sc.layers[j].order
+	})
+	sc.version++
+	return l, nil
+}
+
+// GetLayer returns the layer that has specified name. nil if not found.
+func (glpeer *GLPeer) GetLayer(name string) *Layer {
+	sc := glpeer.getScene()
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	for _, l := range sc.layers {
+		if l.name == name {
+			return l
+		}
+	}
+	return nil
+}
+
+// GetLayers returns layers from back to front
+func (glpeer *GLPeer) GetLayers() []*Layer {
+	return glpeer.getScene().sortedLayers()
+}
+
+// RemoveAllLayers removes all layers and resets the default layer.
+// Sprites belonging to removed layers are drawn in the default layer.
+func (glpeer *GLPeer) RemoveAllLayers() {
+	sc := glpeer.getScene()
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.defaultLayer = &Layer{name: DefaultLayerName, scene: sc}
+	sc.layers = []*Layer{sc.defaultLayer}
+	sc.version++
+}
+
+// layerOrder looks up indices of layers from back to front
+type layerOrder struct {
+	indices map[*Layer]int
+	// def is index of the default layer
+	def int
+}
+
+// newLayerOrder returns layerOrder of specified layers from back to front
+func newLayerOrder(list []*Layer) layerOrder {
+	lo := layerOrder{indices: make(map[*Layer]int, len(list))}
+	for i, l := range list {
+		lo.indices[l] = i
+		if l.name == DefaultLayerName {
+			lo.def = i
+		}
+	}
+	return lo
+}
+
+// index returns index of the layer. Removed layer is regarded as the default layer.
+func (lo layerOrder) index(l *Layer) int {
+	if i, ok := lo.indices[l]; ok {
+		return i
+	}
+	return lo.def
+}
+
+// SetLayer sets the layer that sprite belongs to.
+// nil means the default layer.
+func (s *Sprite) SetLayer(l *Layer) {
+	if l != nil && l == l.scene.getDefaultLayer() {
+		l = nil
+	}
+	if s.layer == l {
+		return
+	}
+	if s.layer != nil {
+		s.layer.scene.touch()
+	}
+	s.layer = l
+	if l != nil {
+		l.scene.touch()
+	}
+}
+
+// GetLayer returns the layer that sprite belongs to.
+func (s *Sprite) GetLayer() *Layer {
+	if s.layer == nil {
+		return s.getScene().getDefaultLayer()
+	}
+	return s.layer
+}
@@ -0,0 +1,175 @@
+package peer
+
+import "testing"
+
+func newLayerTestNode(s *Sprite, z int) *ZNode {
+	return &ZNode{ZIndex: z, sprite: s}
+}
+
+func TestAddLayer(t *testing.T) {
+	glpeer := &GLPeer{}
+	ui, err := glpeer.AddLayer("ui", 10)
+	if err != nil {
+		t.Fatalf("failed to add layer. err: %s", err.Error())
+	}
+	bg, err := glpeer.AddLayer("background", -10)
+	if err != nil {
+		t.Fatalf("failed to add layer. err: %s", err.Error())
+	}
+	if _, err = glpeer.AddLayer("ui", 0); err == nil {
+		t.Errorf("duplicated name should be an error")
+	}
+	if glpeer.GetLayer("ui") != ui || glpeer.GetLayer("nothing") != nil {
+		t.Errorf("unexpected result of GetLayer")
+	}
+
+	list := glpeer.GetLayers()
+	def := glpeer.GetLayer(DefaultLayerName)
+	if len(list) != 3 || list[0] != bg || list[1] != def || list[2] != ui {
+		t.Errorf("layers should be sorted by order")
+	}
+
+	glpeer.RemoveAllLayers()
+	if glpeer.GetLayer("ui") != nil || len(glpeer.GetLayers()) != 1 {
+		t.Errorf("layers should be removed except for default layer")
+	}
+}
+
+func TestArrangeLayers(t *testing.T) {
+	glpeer := &GLPeer{}
+	ui, _ := glpeer.AddLayer("ui", 10)
+	world, _ := glpeer.AddLayer("world", 5)
+	world.SetSortMode(SortByY)
+
+	button := &Sprite{}
+	button.SetLayer(ui)
+	near := &Sprite{Y: 10}
+	near.SetLayer(world)
+	far := &Sprite{Y: 100}
+	far.SetLayer(world)
+	bg1 := &Sprite{}
+	bg2 := &Sprite{}
+
+	for _, zn := range []*ZNode{
+		newLayerTestNode(button, 0),
+		newLayerTestNode(near, 0),
+		newLayerTestNode(far, 0),
+		newLayerTestNode(bg1, 1),
+		newLayerTestNode(bg2, 0),
+	} {
+		glpeer.AppendNode(zn)
+	}
+
+	order := func() []*Sprite {
+		var sprites []*Sprite
+		for _, znodes := range glpeer.layerNodes {
+			for _, zn := range znodes {
+				sprites = append(sprites, zn.sprite)
+			}
+		}
+		return sprites
+	}
+	check := func(want []*Sprite) {
+		t.Helper()
+		got := order()
+		if len(got) != len(want) {
+			t.Fatalf("unexpected result. [got] %d [want] %d", len(got), len(want))
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("unexpected order at %d", i)
+			}
+		}
+	}
+
+	glpeer.arrange()
+	check([]*Sprite{bg1, bg2, far, near, button})
+
+	// y sorted layer is sorted every frame
+	near.Y = 200
+	glpeer.arrange()
+	check([]*Sprite{bg1, bg2, near, far, button})
+
+	// zindex change sorts the layer
+	glpeer.layerNodes[0][0].ZIndex = -1
+	glpeer.ZIndexDirty(glpeer.layerNodes[0][0])
+	glpeer.arrange()
+	check([]*Sprite{bg2, bg1, near, far, button})
+
+	// changing layer groups nodes again. nodes of the same zindex keep added order.
+	button.SetLayer(nil)
+	glpeer.arrange()
+	check([]*Sprite{button, bg2, bg1, near, far})
+}
+
+func TestHiddenLayer(t *testing.T) {
+	glpeer := &GLPeer{}
+	ui, _ := glpeer.AddLayer("ui", 10)
+	s := &Sprite{W: 10, H: 10}
+	s.SetLayer(ui)
+	ui.SetVisible(false)
+	if s.IsVisible() || s.Contains(0, 0) {
+		t.Errorf("sprite in hidden layer should not be visible")
+	}
+	ui.SetVisible(true)
+	if !s.IsVisible() || !s.Contains(0, 0) {
+		t.Errorf("sprite in visible layer should be visible")
+	}
+}
+
+func TestTouchOrderByLayer(t *testing.T) {
+	gl := &mockGLer{}
+	sc := &SpriteContainer{}
+	sc.gl = gl
+
+	ui, _ := gl.layers.AddLayer("ui", 10)
+	world, _ := gl.layers.AddLayer("world", 5)
+	world.SetSortMode(SortByY)
+
+	button := &Sprite{Y: 100}
+	button.SetLayer(ui)
+	far := &Sprite{Y: 50}
+	far.SetLayer(world)
+	near := &Sprite{Y: 10}
+	near.SetLayer(world)
+	bg := &Sprite{}
+	for _, s := range []*Sprite{button, far, near, bg} {
+		if err := sc.AddSprite(s, nil, nil); err != nil {
+			t.Fatalf(err.Error())
+		}
+	}
+	// zindex doesn't matter across layers
+	_ = sc.SetZIndex(bg, -100)
+
+	want := []*Sprite{button, near, far, bg}
+	for i, sn := range sc.sortedSpriteNodePairs() {
+		if sn.sprite != want[i] {
+			t.Errorf("unexpected order at %d", i)
+		}
+	}
+}
+
+func TestLayersOfPeers(t *testing.T) {
+	p1 := &GLPeer{}
+	p2 := &GLPeer{}
+	ui, _ := p1.AddLayer("ui", 10)
+	if p2.GetLayer("ui") != nil {
+		t.Errorf("layers should not be shared by peers")
+	}
+	if _, err := p2.AddLayer("ui", 10); err != nil {
+		t.Errorf("unexpected error. err: %s", err.Error())
+	}
+	if p1.GetCamera() == p2.GetCamera() {
+		t.Errorf("main camera should not be shared by peers")
+	}
+
+	// sprite in removed layer is sorted in the default layer
+	s := &Sprite{}
+	s.SetLayer(ui)
+	p1.AppendNode(newLayerTestNode(s, 0))
+	p1.RemoveAllLayers()
+	p1.arrange()
+	if len(p1.layerNodes) != 1 || len(p1.layerNodes[0]) != 1 {
+		t.Errorf("unexpected result. [got] %v", p1.layerNodes)
+	}
+}
@@ -0,0 +1,165 @@
+package peer
+
+import (
+	"image"
+	"image/color"
+	"sort"
+
+	"github.com/pankona/gomo-simra/simra/simlog"
+)
+
+// Material represents a fragment shader effect applied to sprites.
+//
+// Source of material is GLSL ES 1.0 that declares uniforms and a function
+//
+//	vec4 effect(sampler2D tex, vec2 uv)
+//
+// that returns premultiplied color at uv of sprite's texture.
+// The returned color is multiplied by sprite's alpha and tint.
+// The function can use following built-in variables and functions:
+//
+//	uniform vec2 texelSize;  // size of a texel in texture coordinates
+//	uniform vec4 uvRect;     // sub rectangle of texture drawn by sprite
+//	vec2 toUnit(vec2 uv);    // converts uv to 0..1 of the sub rectangle
+//	vec4 sampleRect(sampler2D tex, vec2 uv); // transparent outside of the sub rectangle
+type Material struct {
+	source   string
+	uniforms map[string]interface{}
+	textures map[string]*Texture
+	images   map[string]image.Image
+	// reference is CPU implementation of the effect.
+	// nil if the material has no reference implementation.
+	reference func(m *Material, s sampler, u, v float32) [4]float32
+}
+
+// NewMaterial returns a material that has specified source.
+// The source is compiled when it is drawn at first, or by CompileMaterial.
+func NewMaterial(source string) *Material {
+	return &Material{
+		source:   source,
+		uniforms: make(map[string]interface{}),
+		textures: make(map[string]*Texture),
+		images:   make(map[string]image.Image),
+	}
+}
+
+// SetFloat sets a float uniform
+func (m *Material) SetFloat(name string, v float32) {
+	m.uniforms[name] = v
+}
+
+// GetFloat returns a float uniform. 0 if not set.
+func (m *Material) GetFloat(name string) float32 {
+	v, _ := m.uniforms[name].(float32)
+	return v
+}
+
+// SetVec2 sets a vec2 uniform
+func (m *Material) SetVec2(name string, x, y float32) {
+	m.uniforms[name] = [2]float32{x, y}
+}
+
+// SetVec4 sets a vec4 uniform
+func (m *Material) SetVec4(name string, x, y, z, w float32) {
+	m.uniforms[name] = [4]float32{x, y, z, w}
+}
+
+// SetColor sets a vec4 uniform by color. Components are premultiplied and in 0..1.
+func (m *Material) SetColor(name string, c color.RGBA) {
+	m.uniforms[name] = toFloatColor(c)
+}
+
+// vec4 returns a vec4 uniform. zero if not set.
+func (m *Material) vec4(name string) [4]float32 {
+	v, _ := m.uniforms[name].([4]float32)
+	return v
+}
+
+// SetTexture binds specified texture to a sampler2D uniform.
+// Sampling coordinates are 0..1 of whole texture, whose size is
+// the image's size rounded up to power of 2.
+func (m *Material) SetTexture(name string, t *Texture) {
+	delete(m.images, name)
+	m.textures[name] = t
+}
+
+// SetImage binds specified image to a sampler2D uniform.
+// The image is uploaded to GPU when it is drawn at first.
+// Sampling coordinates are 0..1 of the image if its size is power of 2,
+// otherwise the image is padded as SetTexture.
+func (m *Material) SetImage(name string, img image.Image) {
+	delete(m.textures, name)
+	m.images[name] = img
+}
+
+// samplerNames returns names of sampler2D uniforms in stable order
+func (m *Material) samplerNames() []string {
+	var names []string
+	for name := range m.textures {
+		names = append(names, name)
+	}
+	for name := range m.images {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sampler samples texels of sub rectangle of a texture on CPU
+type sampler struct {
+	t *texture
+	r image.Rectangle
+}
+
+// at returns premultiplied color at (u, v) in 0..1 of sub rectangle.
+// transparent outside of the sub rectangle.
+func (s sampler) at(u, v float32) [4]float32 {
+	if u < 0 || u >= 1 || v < 0 || v >= 1 {
+		return [4]float32{}
+	}
+	return toFloatColor(sampleTexel(s.t, s.r, u, v))
+}
+
+// texel returns size of a texel in 0..1 of sub rectangle
+func (s sampler) texel() (float32, float32) {
+	return 1 / float32(s.r.Dx()), 1 / float32(s.r.Dy())
+}
+
+// sampleImage returns premultiplied color at (u, v) in 0..1 of the image
+func sampleImage(img image.Image, u, v float32) [4]float32 {
+	if img == nil || u < 0 || u >= 1 || v < 0 || v >= 1 {
+		return [4]float32{}
+	}
+	b := img.Bounds()
+	c := color.RGBAModel.Convert(img.At(
+		b.Min.X+int(u*float32(b.Dx())),
+		b.Min.Y+int(v*float32(b.Dy())))).(color.RGBA)
+	return toFloatColor(c)
+}
+
+// CompileMaterial compiles specified material with GL context that GLPeer holds,
+// and returns error of compilation. If GL is not available yet, the material
+// is compiled when it is drawn at first.
+func (glpeer *GLPeer) CompileMaterial(m *Material) error {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+
+	if glpeer.eng == nil || glpeer.glc == nil || glpeer.glc.glcontext == nil {
+		return nil
+	}
+	_, err := glpeer.eng.compile(m)
+	return err
+}
+
+// SetMaterial sets material that draws sprite. nil means the default drawing.
+func (s *Sprite) SetMaterial(m *Material) {
+	s.material = m
+}
+
+// GetMaterial returns material that draws sprite. nil if not set.
+func (s *Sprite) GetMaterial() *Material {
+	return s.material
+}
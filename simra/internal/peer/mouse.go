@@ -0,0 +1,149 @@
+package peer
+
+import "github.com/pankona/gomo-simra/simra/simlog"
+
+// MouseButton represents a button of mouse
+type MouseButton int
+
+const (
+	// MouseButtonNone represents that no button is related to the event
+	MouseButtonNone MouseButton = iota
+	// MouseButtonLeft represents left button of mouse
+	MouseButtonLeft
+	// MouseButtonMiddle represents middle button (wheel click) of mouse
+	MouseButtonMiddle
+	// MouseButtonRight represents right button of mouse
+	MouseButtonRight
+)
+
+// MouseListener is interface to be notified mouse event.
+type MouseListener interface {
+	// OnMouseMove is called when mouse cursor is moved.
+	// This is called regardless of whether any button is pressed or not.
+	OnMouseMove(x, y float32)
+	// OnMouseDown is called when specified button is pressed.
+	OnMouseDown(x, y float32, button MouseButton)
+	// OnMouseUp is called when specified button is released.
+	OnMouseUp(x, y float32, button MouseButton)
+	// OnMouseWheel is called when mouse wheel is scrolled.
+	// dy is positive for scroll up, dx is positive for scroll right.
+	OnMouseWheel(x, y, dx, dy float32)
+}
+
+// PointerListener is interface to be notified hover event of a sprite.
+type PointerListener interface {
+	// OnPointerEnter is called when mouse cursor enters into sprite's rectangle.
+	OnPointerEnter(x, y float32)
+	// OnPointerLeave is called when mouse cursor leaves from sprite's rectangle.
+	OnPointerLeave(x, y float32)
+}
+
+// Mouser represents an interface for mouse controller
+type Mouser interface {
+	// AddMouseListener registers a listener to notify mouse event.
+	AddMouseListener(listener MouseListener)
+	// RemoveMouseListener removes specified listener.
+	RemoveMouseListener(listener MouseListener)
+	// RemoveAllMouseListeners removes all registered listeners.
+	RemoveAllMouseListeners()
+	// OnMouseMove is called when mouse cursor is moved.
+	// This event is notified to all registered listeners.
+	OnMouseMove(pxx, pxy float32)
+	// OnMouseDown is called when a button of mouse is pressed.
+	// This event is notified to all registered listeners.
+	OnMouseDown(pxx, pxy float32, button MouseButton)
+	// OnMouseUp is called when a button of mouse is released.
+	// This event is notified to all registered listeners.
+	OnMouseUp(pxx, pxy float32, button MouseButton)
+	// OnMouseWheel is called when mouse wheel is scrolled.
+	// This event is notified to all registered listeners.
+	OnMouseWheel(pxx, pxy, dx, dy float32)
+}
+
+// MousePeer represents a Mouse object.
+// Singleton.
+type MousePeer struct {
+	screensize     *screenSize
+	mouseListeners []MouseListener
+}
+
+var mousePeer = &MousePeer{}
+
+// GetMousePeer returns instance of MousePeer.
+// Since MousePeer is singleton, it is necessary to
+// call this function to get instance of MousePeer.
+func GetMousePeer() Mouser {
+	mousePeer.screensize = screensize
+	return mousePeer
+}
+
+// AddMouseListener registers a listener to notify mouse event.
+func (mp *MousePeer) AddMouseListener(listener MouseListener) {
+	simlog.FuncIn()
+	mp.mouseListeners = append(mp.mouseListeners, listener)
+	simlog.FuncOut()
+}
+
+// RemoveMouseListener removes specified listener.
+func (mp *MousePeer) RemoveMouseListener(listener MouseListener) {
+	simlog.FuncIn()
+	result := []MouseListener{}
+	for _, l := range mp.mouseListeners {
+		if l != listener {
+			result = append(result, l)
+		}
+	}
+	mp.mouseListeners = result
+	simlog.FuncOut()
+}
+
+// RemoveAllMouseListeners removes all registered listeners.
+func (mp *MousePeer) RemoveAllMouseListeners() {
+	simlog.FuncIn()
+	mp.mouseListeners = nil
+	simlog.FuncOut()
+}
+
+// OnMouseMove is called when mouse cursor is moved.
+// This event is notified to all registered listeners.
+func (mp *MousePeer) OnMouseMove(pxx, pxy float32) {
+	simlog.FuncIn()
+	x, y := mp.screensize.calcVirtualPosition(pxx, pxy)
+	for i := range mp.mouseListeners {
+		mp.mouseListeners[i].OnMouseMove(x, y)
+	}
+	simlog.FuncOut()
+}
+
+// OnMouseDown is called when a button of mouse is pressed.
+// This event is notified to all registered listeners.
+func (mp *MousePeer) OnMouseDown(pxx, pxy float32, button MouseButton) {
+	simlog.FuncIn()
+	x, y := mp.screensize.calcVirtualPosition(pxx, pxy)
+	for i := range mp.mouseListeners {
+		mp.mouseListeners[i].OnMouseDown(x, y, button)
+	}
+	simlog.FuncOut()
+}
+
+// OnMouseUp is called when a button of mouse is released.
+// This event is notified to all registered listeners.
+func (mp *MousePeer) OnMouseUp(pxx, pxy float32, button MouseButton) {
+	simlog.FuncIn()
+	x, y := mp.screensize.calcVirtualPosition(pxx, pxy)
+	for i := range mp.mouseListeners {
+		mp.mouseListeners[i].OnMouseUp(x, y, button)
+	}
+	simlog.FuncOut()
+}
+
+// OnMouseWheel is called when mouse wheel is scrolled.
+// This event is notified to all registered listeners.
+func (mp *MousePeer) OnMouseWheel(pxx, pxy, dx, dy float32) {
+	simlog.FuncIn()
+	x, y := mp.screensize.calcVirtualPosition(pxx, pxy)
+	for i := range mp.mouseListeners {
+		mp.mouseListeners[i].OnMouseWheel(x, y, dx, dy)
+	}
+	simlog.FuncOut()
+}
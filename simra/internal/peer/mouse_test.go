@@ -0,0 +1,110 @@
+package peer
+
+import (
+	"testing"
+
+	"golang.org/x/mobile/event/size"
+)
+
+type mouseListener struct {
+	move  func(x, y float32)
+	down  func(x, y float32, button MouseButton)
+	up    func(x, y float32, button MouseButton)
+	wheel func(x, y, dx, dy float32)
+}
+
+func (l *mouseListener) OnMouseMove(x, y float32) {
+	l.move(x, y)
+}
+func (l *mouseListener) OnMouseDown(x, y float32, button MouseButton) {
+	l.down(x, y, button)
+}
+func (l *mouseListener) OnMouseUp(x, y float32, button MouseButton) {
+	l.up(x, y, button)
+}
+func (l *mouseListener) OnMouseWheel(x, y, dx, dy float32) {
+	l.wheel(x, y, dx, dy)
+}
+
+func newTestMousePeer() *MousePeer {
+	ss := &screenSize{}
+	ss.SetScreenSize(size.Event{WidthPt: 100, HeightPt: 200, PixelsPerPt: 1})
+	ss.SetDesiredScreenSize(100, 200)
+	return &MousePeer{
+		screensize: ss,
+	}
+}
+
+func TestGetMousePeer(t *testing.T) {
+	m1 := GetMousePeer()
+	m2 := GetMousePeer()
+
+	if m1 != m2 {
+		t.Error("unexpected result .GetMousePeer should return same address")
+	}
+}
+
+func TestAddRemoveMouseListener(t *testing.T) {
+	mouse := newTestMousePeer()
+	listeners := make([]*mouseListener, 10)
+	for i := range listeners {
+		listeners[i] = &mouseListener{}
+		mouse.AddMouseListener(listeners[i])
+		if len(mouse.mouseListeners) != i+1 {
+			t.Errorf("unexpected result. [got] %d [want] %d", len(mouse.mouseListeners), i+1)
+		}
+	}
+
+	mouse.RemoveMouseListener(listeners[0])
+	if len(mouse.mouseListeners) != 9 {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(mouse.mouseListeners), 9)
+	}
+
+	mouse.RemoveAllMouseListeners()
+	if len(mouse.mouseListeners) != 0 {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(mouse.mouseListeners), 0)
+	}
+}
+
+func TestMouseEvents(t *testing.T) {
+	mouse := newTestMousePeer()
+
+	var gotButton MouseButton
+	var gotX, gotY, gotDX, gotDY float32
+	mouse.AddMouseListener(&mouseListener{
+		move: func(x, y float32) {
+			gotX, gotY = x, y
+		},
+		down: func(x, y float32, button MouseButton) {
+			gotButton = button
+		},
+		up: func(x, y float32, button MouseButton) {
+			gotButton = button
+		},
+		wheel: func(x, y, dx, dy float32) {
+			gotDX, gotDY = dx, dy
+		},
+	})
+
+	// device's pixel position is converted to virtual screen position.
+	// y axis is flipped since origin of virtual screen is bottom left.
+	mouse.OnMouseMove(10, 50)
+	if gotX != 10 || gotY != 150 {
+		t.Errorf("unexpected position. [got] (%f, %f) [want] (%f, %f)", gotX, gotY, 10.0, 150.0)
+	}
+
+	mouse.OnMouseDown(0, 0, MouseButtonRight)
+	if gotButton != MouseButtonRight {
+		t.Errorf("unexpected button. [got] %d [want] %d", gotButton, MouseButtonRight)
+	}
+
+	mouse.OnMouseUp(0, 0, MouseButtonLeft)
+	if gotButton != MouseButtonLeft {
+		t.Errorf("unexpected button. [got] %d [want] %d", gotButton, MouseButtonLeft)
+	}
+
+	mouse.OnMouseWheel(0, 0, 0, -1)
+	if gotDX != 0 || gotDY != -1 {
+		t.Errorf("unexpected wheel delta. [got] (%f, %f) [want] (%f, %f)", gotDX, gotDY, 0.0, -1.0)
+	}
+}
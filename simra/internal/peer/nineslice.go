@@ -0,0 +1,267 @@
+package peer
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"math"
+
+	"github.com/pankona/gomo-simra/simra/simlog"
+	"golang.org/x/mobile/exp/f32"
+)
+
+// NineSlice represents insets in pixels that divide a texture into nine pieces.
+// Corners are drawn in their size, one pixel per unit of sprite's size,
+// edges are stretched along them and the center is stretched or tiled.
+type NineSlice struct {
+	Left, Top, Right, Bottom int
+	// TileCenter is true if the center is repeated instead of stretched
+	TileCenter bool
+}
+
+// slicePiece is a piece of a nine-sliced texture
+type slicePiece struct {
+	// r is area of the piece in the texture
+	r image.Rectangle
+	// m maps the unit square to the area of the piece in the unit square of sprite
+	m f32.Affine
+	// uv maps the unit square to the area drawn in the unit square of r
+	uv f32.Affine
+}
+
+// sliceSpan is a range of a piece along an axis
+type sliceSpan struct {
+	// t0 and t1 are range in the texture in pixels
+	t0, t1 int
+	// s0 and s1 are range in the unit square of sprite
+	s0, s1 float32
+}
+
+// sliceAxis divides range of texture from min to max by insets a and b, for
+// sprite of specified size. spans are before, center and after the insets.
+// tiles are spans of the center repeated if tile is true.
+// Insets are shrunk if they don't fit in the size.
+func sliceAxis(min, max, a, b int, size float32, tile bool) (spans [3]sliceSpan, tiles []sliceSpan) {
+	n := max - min
+	if a > n {
+		a = n
+	}
+	if b > n-a {
+		b = n - a
+	}
+	size = float32(math.Abs(float64(size)))
+	if size == 0 {
+		return spans, nil
+	}
+	k := float32(1)
+	if float32(a+b) > size {
+		k = size / float32(a+b)
+	}
+	p1, p2 := float32(a)*k/size, 1-float32(b)*k/size
+	spans = [3]sliceSpan{
+		{min, min + a, 0, p1},
+		{min + a, max - b, p1, p2},
+		{max - b, max, p2, 1},
+	}
+	c := n - a - b
+	if !tile || c == 0 || p2 <= p1 {
+		return spans, spans[1:2]
+	}
+	// a tile has its size in pixels. the last tile is cut off.
+	tw := float32(c) / size
+	count := int(math.Ceil(float64((p2-p1)/tw) - 1e-3))
+	for i := 0; i < count; i++ {
+		s0 := p1 + tw*float32(i)
+		s1 := min32(s0+tw, p2)
+		t1 := min + a + int(math.Round(float64((s1-s0)/tw*float32(c))))
+		tiles = append(tiles, sliceSpan{min + a, t1, s0, s1})
+	}
+	return spans, tiles
+}
+
+// pieces appends pieces of sub rectangle r of a texture drawn by sprite of
+// w x h to dst
+func (ns *NineSlice) pieces(dst []slicePiece, r image.Rectangle, w, h float32) []slicePiece {
+	xs, xtiles := sliceAxis(r.Min.X, r.Max.X, ns.Left, ns.Right, w, ns.TileCenter)
+	ys, ytiles := sliceAxis(r.Min.Y, r.Max.Y, ns.Top, ns.Bottom, h, ns.TileCenter)
+	for j := range ys {
+		for i := range xs {
+			if i != 1 || j != 1 {
+				dst = appendPiece(dst, xs[i], ys[j])
+				continue
+			}
+			for _, y := range ytiles {
+				for _, x := range xtiles {
+					dst = appendPiece(dst, x, y)
+				}
+			}
+		}
+	}
+	return dst
+}
+
+// appendPiece appends a piece of spans x and y to dst unless it is empty
+func appendPiece(dst []slicePiece, x, y sliceSpan) []slicePiece {
+	if x.t0 == x.t1 || y.t0 == y.t1 || x.s0 == x.s1 || y.s0 == y.s1 {
+		return dst
+	}
+	return append(dst, slicePiece{
+		r: image.Rect(x.t0, y.t0, x.t1, y.t1),
+		m: f32.Affine{
+			{x.s1 - x.s0, 0, x.s0},
+			{0, y.s1 - y.s0, y.s0},
+		},
+		uv: identityAffine,
+	})
+}
+
+// texturePieces returns pieces of nine-sliced or repeated texture drawn by
+// the node. nil if the sprite of the node draws whole texture once.
+func (zn *ZNode) texturePieces() []slicePiece {
+	s := zn.sprite
+	if s == nil {
+		return nil
+	}
+	sheet := zn.Node.EngineFields.SubTex.R
+	r := sheet
+	if zn.rotated {
+		// pieces are made in the image and turned into the sheet
+		r = image.Rect(0, 0, sheet.Dy(), sheet.Dx())
+	}
+	switch {
+	case s.nineSlice != nil:
+		zn.pieces = s.nineSlice.pieces(zn.pieces[:0], r, s.W, s.H)
+	case s.wrap != nil:
+		zn.pieces = s.wrap.pieces(zn.pieces[:0], &zn.wrapSpans[0], &zn.wrapSpans[1], r)
+	default:
+		return nil
+	}
+	if zn.rotated {
+		for i := range zn.pieces {
+			zn.pieces[i].rotate(sheet)
+		}
+	}
+	return zn.pieces
+}
+
+// rotate makes the piece of an image draw the area of the image rotated by
+// 90 degrees clockwise in sheet. Area of the piece is relative to the image.
+func (p *slicePiece) rotate(sheet image.Rectangle) {
+	r, h := p.r, sheet.Dx()
+	p.r = image.Rect(
+		sheet.Min.X+h-r.Max.Y, sheet.Min.Y+r.Min.X,
+		sheet.Min.X+h-r.Min.Y, sheet.Min.Y+r.Max.X)
+	var m, uv f32.Affine
+	m.Mul(&p.m, &rotateAffine)
+	uv.Mul(&unrotateAffine, &p.uv)
+	p.m = m
+	p.uv.Mul(&uv, &rotateAffine)
+}
+
+// SetNineSlice draws texture of sprite by nine pieces divided by specified insets,
+// so that corners are not scaled by size of sprite. nil draws whole texture.
+func (s *Sprite) SetNineSlice(ns *NineSlice) {
+	if ns == nil {
+		s.nineSlice = nil
+		return
+	}
+	c := *ns
+	s.nineSlice = &c
+}
+
+// GetNineSlice returns insets of nine-slice drawing. nil if not nine-sliced.
+func (s *Sprite) GetNineSlice() *NineSlice {
+	if s.nineSlice == nil {
+		return nil
+	}
+	c := *s.nineSlice
+	return &c
+}
+
+// isNinePatchMarker returns true if the pixel of nine-patch border marks an area
+func isNinePatchMarker(img image.Image, x, y int) bool {
+	r, g, b, a := img.At(x, y).RGBA()
+	return r == 0 && g == 0 && b == 0 && a == 0xffff
+}
+
+// ninePatchRange returns the first and the last marked positions of n pixels
+// of the border. ok is false if no pixel is marked.
+func ninePatchRange(n int, marked func(i int) bool) (first, last int, ok bool) {
+	first, last = -1, -1
+	for i := 0; i < n; i++ {
+		if marked(i) {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	return first, last, first >= 0
+}
+
+// parseNinePatch returns the image of Android's nine-patch without its
+// one-pixel border, and insets derived from stretchable areas marked by black
+// pixels on top and left of the border. Multiple stretchable areas are merged
+// into one. Padding marked on right and bottom is ignored.
+func parseNinePatch(img image.Image) (*image.RGBA, *NineSlice, error) {
+	b := img.Bounds()
+	w, h := b.Dx()-2, b.Dy()-2
+	if w <= 0 || h <= 0 {
+		return nil, nil, errors.New("nine-patch image is too small")
+	}
+	x0, x1, ok := ninePatchRange(w, func(i int) bool {
+		return isNinePatchMarker(img, b.Min.X+1+i, b.Min.Y)
+	})
+	if !ok {
+		return nil, nil, errors.New("no horizontal stretchable area in nine-patch")
+	}
+	y0, y1, ok := ninePatchRange(h, func(i int) bool {
+		return isNinePatchMarker(img, b.Min.X, b.Min.Y+1+i)
+	})
+	if !ok {
+		return nil, nil, errors.New("no vertical stretchable area in nine-patch")
+	}
+	content := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(content, content.Bounds(), img, b.Min.Add(image.Pt(1, 1)), draw.Src)
+	return content, &NineSlice{
+		Left:   x0,
+		Top:    y0,
+		Right:  w - 1 - x1,
+		Bottom: h - 1 - y1,
+	}, nil
+}
+
+// LoadNinePatch loads Android's nine-patch image (.9.png) of specified asset.
+// Returned texture is the image without its border, and returned insets
+// divide the texture by its stretchable area.
+func (glpeer *GLPeer) LoadNinePatch(assetName string) (*Texture, *NineSlice, error) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	content, ns, err := decodeNinePatch(assetName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+	return glpeer.newTextureFromImage(content, func() (image.Image, error) {
+		content, _, err := decodeNinePatch(assetName)
+		return content, err
+	}, "ninepatch:"+assetName), ns, nil
+}
+
+// decodeNinePatch returns the image of nine-patch asset without its border,
+// and its insets
+func decodeNinePatch(assetName string) (*image.RGBA, *NineSlice, error) {
+	img, err := decodeAsset(assetName)
+	if err != nil {
+		return nil, nil, err
+	}
+	content, ns, err := parseNinePatch(img)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", assetName, err)
+	}
+	return content, ns, nil
+}
@@ -0,0 +1,164 @@
+package peer
+
+import (
+	"image"
+	"image/color"
+	"reflect"
+	"testing"
+)
+
+func TestSliceAxis(t *testing.T) {
+	tcs := []struct {
+		name  string
+		size  float32
+		tile  bool
+		spans [3]sliceSpan
+		tiles []sliceSpan
+	}{
+		{
+			name: "stretch",
+			size: 100,
+			spans: [3]sliceSpan{
+				{10, 20, 0, 0.1},
+				{20, 30, 0.1, 0.9},
+				{30, 40, 0.9, 1},
+			},
+			tiles: []sliceSpan{{20, 30, 0.1, 0.9}},
+		},
+		{
+			// insets are shrunk to fit in the size
+			name: "shrink",
+			size: 15,
+			spans: [3]sliceSpan{
+				{10, 20, 0, 0.5},
+				{20, 30, 0.5, 0.5},
+				{30, 40, 0.5, 1},
+			},
+			tiles: []sliceSpan{{20, 30, 0.5, 0.5}},
+		},
+		{
+			name: "tile",
+			size: 55,
+			tile: true,
+			spans: [3]sliceSpan{
+				{10, 20, 0, 10.0 / 55},
+				{20, 30, 10.0 / 55, 45.0 / 55},
+				{30, 40, 45.0 / 55, 1},
+			},
+			tiles: []sliceSpan{
+				{20, 30, 10.0 / 55, 20.0 / 55},
+				{20, 30, 20.0 / 55, 30.0 / 55},
+				{20, 30, 30.0 / 55, 40.0 / 55},
+				{20, 25, 40.0 / 55, 45.0 / 55},
+			},
+		},
+	}
+	near := func(a, b []sliceSpan) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i].t0 != b[i].t0 || a[i].t1 != b[i].t1 ||
+				!nearlyEqual(a[i].s0, b[i].s0) || !nearlyEqual(a[i].s1, b[i].s1) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, tc := range tcs {
+		// texture from 10 to 40 whose insets are 10
+		spans, tiles := sliceAxis(10, 40, 10, 10, tc.size, tc.tile)
+		if !near(spans[:], tc.spans[:]) {
+			t.Errorf("[%s] unexpected result. [got] %v [want] %v", tc.name, spans, tc.spans)
+		}
+		if !near(tiles, tc.tiles) {
+			t.Errorf("[%s] unexpected result. [got] %v [want] %v", tc.name, tiles, tc.tiles)
+		}
+	}
+}
+
+func TestNineSlicePieces(t *testing.T) {
+	ns := &NineSlice{Left: 10, Top: 10, Right: 10, Bottom: 10}
+	r := image.Rect(0, 0, 30, 30)
+	pieces := ns.pieces(nil, r, 100, 50)
+	if len(pieces) != 9 {
+		t.Fatalf("unexpected result. [got] %d [want] %d", len(pieces), 9)
+	}
+	// top left corner keeps its size, and the center is stretched
+	if want := image.Rect(0, 0, 10, 10); pieces[0].r != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", pieces[0].r, want)
+	}
+	if p := pieces[0].m; !nearlyEqual(p[0][0], 0.1) || !nearlyEqual(p[1][1], 0.2) {
+		t.Errorf("unexpected result. [got] %v", p)
+	}
+	if p := pieces[4].m; !nearlyEqual(p[0][0], 0.8) || !nearlyEqual(p[1][1], 0.6) ||
+		!nearlyEqual(p[0][2], 0.1) || !nearlyEqual(p[1][2], 0.2) {
+		t.Errorf("unexpected result. [got] %v", p)
+	}
+
+	// tiled center is repeated in both axes, while edges are stretched
+	ns.TileCenter = true
+	pieces = ns.pieces(pieces[:0], r, 40, 40)
+	if len(pieces) != 8+4 {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(pieces), 8+4)
+	}
+}
+
+func TestParseNinePatch(t *testing.T) {
+	black := color.RGBA{A: 255}
+	red := color.RGBA{R: 255, A: 255}
+	// 5 x 4 content with border
+	img := image.NewRGBA(image.Rect(0, 0, 7, 6))
+	img.Set(1, 1, red)
+	// stretchable areas are merged into x: 1..3 and y: 2..2
+	img.Set(2, 0, black)
+	img.Set(4, 0, black)
+	img.Set(0, 3, black)
+	// padding is ignored
+	img.Set(6, 2, black)
+
+	content, ns, err := parseNinePatch(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &NineSlice{Left: 1, Top: 2, Right: 1, Bottom: 1}
+	if !reflect.DeepEqual(ns, want) {
+		t.Errorf("unexpected result. [got] %v [want] %v", ns, want)
+	}
+	if b := content.Bounds(); b != image.Rect(0, 0, 5, 4) {
+		t.Errorf("unexpected result. [got] %v [want] %v", b, image.Rect(0, 0, 5, 4))
+	}
+	if c := content.RGBAAt(0, 0); c != red {
+		t.Errorf("unexpected result. [got] %v [want] %v", c, red)
+	}
+
+	if _, _, err := parseNinePatch(image.NewRGBA(image.Rect(0, 0, 4, 4))); err == nil {
+		t.Errorf("error should be returned for image without markers")
+	}
+}
+
+func TestRenderNineSlice(t *testing.T) {
+	s := &Sprite{X: 2, Y: 2, W: 4, H: 4}
+	// top and bottom rows of texture keep their height.
+	// the center of the texture is empty.
+	s.SetNineSlice(&NineSlice{Top: 1, Bottom: 1})
+
+	glpeer := &GLPeer{}
+	glpeer.znodes = ZNodes{newTargetTestNode(s)}
+	glpeer.layoutDirty = true
+
+	rt, err := glpeer.NewRenderTarget(4, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt.SetClearColor(black)
+	rt.AddSprite(s)
+	glpeer.RenderTo(rt)
+
+	img := rt.Image()
+	for y, want := range []color.RGBA{red, black, black, blue} {
+		if got := img.RGBAAt(1, y); got != want {
+			t.Errorf("[%d] unexpected result. [got] %v [want] %v", y, got, want)
+		}
+	}
+}
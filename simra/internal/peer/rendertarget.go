@@ -0,0 +1,382 @@
+package peer
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/pankona/gomo-simra/simra/simlog"
+	"golang.org/x/mobile/event/size"
+	"golang.org/x/mobile/exp/f32"
+	"golang.org/x/mobile/exp/sprite"
+	"golang.org/x/mobile/exp/sprite/clock"
+	"golang.org/x/mobile/geom"
+	"golang.org/x/mobile/gl"
+)
+
+// RenderTarget represents an offscreen image that sprites are rendered into.
+// Rendered image can be used as a texture of sprites, such as pre-composited
+// background, minimap, or snapshot of the scene.
+type RenderTarget struct {
+	width, height int
+	// source rectangle in world coordinates that is rendered to whole target.
+	// (srcX, srcY) is bottom left.
+	srcX, srcY, srcW, srcH float32
+	sprites                []*Sprite
+	layers                 []*Layer
+	clearColor             color.RGBA
+	tex                    *texture
+	fbo                    gl.Framebuffer
+	// rbo is stencil buffer of the target. stencil is true if it is available.
+	rbo     gl.Renderbuffer
+	stencil bool
+	// pending is true while rendering on GPU is requested
+	pending bool
+}
+
+// SetSourceRect sets rectangle in world coordinates that is rendered to whole target.
+// (x, y) is bottom left of the rectangle. Default is (0, 0, width, height) of the target.
+func (rt *RenderTarget) SetSourceRect(x, y, w, h float32) {
+	rt.srcX, rt.srcY, rt.srcW, rt.srcH = x, y, w, h
+}
+
+// GetSourceRect returns rectangle in world coordinates that is rendered to whole target.
+func (rt *RenderTarget) GetSourceRect() (x, y, w, h float32) {
+	return rt.srcX, rt.srcY, rt.srcW, rt.srcH
+}
+
+// AddSprite adds specified sprite and its descendants to the sprites to render.
+func (rt *RenderTarget) AddSprite(s *Sprite) {
+	rt.sprites = append(rt.sprites, s)
+}
+
+// AddLayer adds sprites of specified layer to the sprites to render.
+// They are rendered even if the layer is hidden, so that a hidden layer
+// can be used to compose sprites only for render target.
+func (rt *RenderTarget) AddLayer(l *Layer) {
+	rt.layers = append(rt.layers, l)
+}
+
+// ClearSources removes all sprites and layers to render.
+func (rt *RenderTarget) ClearSources() {
+	rt.sprites = nil
+	rt.layers = nil
+}
+
+// SetClearColor sets color that fills the target before rendering.
+// Default is transparent.
+func (rt *RenderTarget) SetClearColor(c color.RGBA) {
+	rt.clearColor = c
+}
+
+// Texture returns a texture that shows rendered image of the target.
+func (rt *RenderTarget) Texture() *Texture {
+	return &Texture{
+		subTex: sprite.SubTex{T: rt.tex, R: image.Rect(0, 0, rt.width, rt.height)},
+	}
+}
+
+// Image returns rendered image of the target.
+// The image is shared with the target, so that it is updated by rendering.
+func (rt *RenderTarget) Image() *image.RGBA {
+	return rt.tex.rgba.SubImage(image.Rect(0, 0, rt.width, rt.height)).(*image.RGBA)
+}
+
+// includes returns true if specified sprite is rendered to the target
+func (rt *RenderTarget) includes(s *Sprite) bool {
+	for _, l := range rt.layers {
+		if s.GetLayer() == l {
+			return true
+		}
+	}
+	for p := s; p != nil; p = p.parent {
+		for _, v := range rt.sprites {
+			if p == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// view returns an affine that maps world coordinates to pixels of the target,
+// whose origin is top left.
+func (rt *RenderTarget) view() f32.Affine {
+	sx := float32(rt.width) / rt.srcW
+	sy := float32(rt.height) / rt.srcH
+	return f32.Affine{
+		{sx, 0, -rt.srcX * sx},
+		{0, -sy, (rt.srcY + rt.srcH) * sy},
+	}
+}
+
+// scissor converts specified clip rectangle to a rectangle of the target in pixels.
+// Since row 0 of framebuffer is top of the target, it is also the scissor box.
+func (rt *RenderTarget) scissor(clip clipRect) [4]int32 {
+	v := rt.view()
+	x0 := v[0][0]*clip.x + v[0][2]
+	x1 := v[0][0]*(clip.x+clip.w) + v[0][2]
+	y0 := v[1][1]*(clip.y+clip.h) + v[1][2]
+	y1 := v[1][1]*clip.y + v[1][2]
+	round := func(f float32) int32 {
+		return int32(math.Floor(float64(f) + 0.5))
+	}
+	return [4]int32{round(x0), round(y0), round(x1) - round(x0), round(y1) - round(y0)}
+}
+
+// clear fills the target with clear color on CPU
+func (rt *RenderTarget) clear() {
+	img := rt.Image()
+	for y := 0; y < rt.height; y++ {
+		for x := 0; x < rt.width; x++ {
+			img.SetRGBA(x, y, rt.clearColor)
+		}
+	}
+}
+
+// NewRenderTarget returns a render target that has specified size in pixels.
+// Render targets are released when scene is changed.
+// An error is returned if size is not positive or its texture can't be created.
+func (glpeer *GLPeer) NewRenderTarget(width, height int) (*RenderTarget, error) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid size of render target. [got] %d x %d", width, height)
+	}
+
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+
+	rt := &RenderTarget{
+		width:  width,
+		height: height,
+		srcW:   float32(width),
+		srcH:   float32(height),
+	}
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	if glpeer.eng != nil && glpeer.glc != nil && glpeer.glc.glcontext != nil {
+		// rendered pixels are kept on CPU as the image of the target
+		t, err := glpeer.eng.loadTexture(img, nil, "render target")
+		if err != nil {
+			return nil, err
+		}
+		rt.tex = t
+	} else {
+		// GL is not available. rendered on CPU only.
+		rt.tex = &texture{
+			b:      img.Bounds(),
+			width:  roundToPower2(width),
+			height: roundToPower2(height),
+		}
+		rt.tex.rgba = image.NewRGBA(image.Rect(0, 0, rt.tex.width, rt.tex.height))
+	}
+	glpeer.targets = append(glpeer.targets, rt)
+	return rt, nil
+}
+
+// RenderTo renders sprites of specified render target into it.
+// If GL is available, the target is rendered on GPU at the beginning of next
+// Update, and read back to CPU. Otherwise it is rendered on CPU immediately.
+func (glpeer *GLPeer) RenderTo(rt *RenderTarget) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+
+	if rt.tex.gltex != (gl.Texture{}) && glpeer.glc != nil && glpeer.glc.glcontext != nil {
+		rt.pending = true
+		return
+	}
+	glpeer.renderSoftware(rt)
+}
+
+// ReleaseRenderTarget releases GL resources of specified render target
+func (glpeer *GLPeer) ReleaseRenderTarget(rt *RenderTarget) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+
+	glpeer.releaseTarget(rt)
+	var targets []*RenderTarget
+	for _, v := range glpeer.targets {
+		if v != rt {
+			targets = append(targets, v)
+		}
+	}
+	glpeer.targets = targets
+}
+
+func (glpeer *GLPeer) releaseTarget(rt *RenderTarget) {
+	rt.pending = false
+	glpeer.releaseFramebuffer(rt)
+	rt.tex.Release()
+}
+
+// releaseFramebuffer releases framebuffer of the target.
+// It is created again when the target is rendered.
+func (glpeer *GLPeer) releaseFramebuffer(rt *RenderTarget) {
+	if rt.fbo != (gl.Framebuffer{}) {
+		glpeer.glc.glcontext.DeleteFramebuffer(rt.fbo)
+		rt.fbo = gl.Framebuffer{}
+	}
+	if rt.rbo != (gl.Renderbuffer{}) {
+		glpeer.glc.glcontext.DeleteRenderbuffer(rt.rbo)
+		rt.rbo = gl.Renderbuffer{}
+	}
+}
+
+// targetNodes returns nodes rendered to specified target in the order of drawing
+func (glpeer *GLPeer) targetNodes(rt *RenderTarget) []*ZNode {
+	var nodes []*ZNode
+	for _, znodes := range glpeer.layerNodes {
+		for _, zn := range znodes {
+			if zn.sprite != nil && rt.includes(zn.sprite) {
+				nodes = append(nodes, zn)
+			}
+		}
+	}
+	return nodes
+}
+
+// targetAffine returns an affine that maps the unit square to pixels of the target
+func targetAffine(rt *RenderTarget, zn *ZNode) f32.Affine {
+	view := rt.view()
+	local := zn.sprite.transform()
+	var affine f32.Affine
+	affine.Mul(&view, &local)
+	zn.applyTrim(&affine)
+	return affine
+}
+
+// targetState returns rendering state of sprite rendered to target
+func (glpeer *GLPeer) targetState(rt *RenderTarget, s *Sprite) *nodeState {
+	alpha := s.worldAlpha()
+	if s.hiddenInHierarchy() {
+		alpha = 0
+	}
+	ns := &nodeState{
+		tint:     premultipliedTint(s.GetTint(), alpha),
+		blend:    s.blend,
+		material: s.material,
+	}
+	if clip, ok := s.clipRect(); ok {
+		ns.scissor, ns.clipped = rt.scissor(clip), true
+	}
+	ns.mask = glpeer.maskOf(s, func(*Sprite) f32.Affine {
+		return rt.view()
+	})
+	return ns
+}
+
+// renderSoftware renders the target on CPU
+func (glpeer *GLPeer) renderSoftware(rt *RenderTarget) {
+	glpeer.arrange()
+	rt.clear()
+	dst := rt.Image()
+	for _, zn := range glpeer.targetNodes(rt) {
+		x := zn.Node.EngineFields.SubTex
+		t, ok := x.T.(*texture)
+		if !ok {
+			continue
+		}
+		m := targetAffine(rt, zn)
+		ns := glpeer.targetState(rt, zn.sprite)
+		pieces := zn.texturePieces()
+		if pieces == nil {
+			drawSoftware(dst, t, x.R, nil, &m, ns)
+		}
+		for i := range pieces {
+			var pm f32.Affine
+			pm.Mul(&m, &pieces[i].m)
+			drawSoftware(dst, t, pieces[i].r, &pieces[i].uv, &pm, ns)
+		}
+	}
+	// sync GL texture with rendered image
+	rt.tex.Upload(rt.tex.b, dst)
+}
+
+// renderTargets renders requested targets on GPU and reads them back to CPU
+func (glpeer *GLPeer) renderTargets(now clock.Time) {
+	glctx := glpeer.glc.glcontext
+	eng := glpeer.eng
+	for _, rt := range glpeer.targets {
+		if !rt.pending {
+			continue
+		}
+		rt.pending = false
+		if rt.tex.gltex == (gl.Texture{}) {
+			glpeer.renderSoftware(rt)
+			continue
+		}
+
+		// restore current framebuffer after rendering
+		current := gl.Framebuffer{Value: uint32(glctx.GetInteger(gl.FRAMEBUFFER_BINDING))}
+		if rt.fbo == (gl.Framebuffer{}) {
+			rt.fbo = glctx.CreateFramebuffer()
+			glctx.BindFramebuffer(gl.FRAMEBUFFER, rt.fbo)
+			glctx.FramebufferTexture2D(gl.FRAMEBUFFER, gl.COLOR_ATTACHMENT0, gl.TEXTURE_2D, rt.tex.gltex, 0)
+			// stencil buffer for masks
+			rt.rbo = glctx.CreateRenderbuffer()
+			glctx.BindRenderbuffer(gl.RENDERBUFFER, rt.rbo)
+			glctx.RenderbufferStorage(gl.RENDERBUFFER, gl.STENCIL_INDEX8, rt.tex.width, rt.tex.height)
+			glctx.FramebufferRenderbuffer(gl.FRAMEBUFFER, gl.STENCIL_ATTACHMENT, gl.RENDERBUFFER, rt.rbo)
+			rt.stencil = glctx.CheckFramebufferStatus(gl.FRAMEBUFFER) == gl.FRAMEBUFFER_COMPLETE
+			if !rt.stencil {
+				// masks are sampled in the shader instead
+				glctx.FramebufferRenderbuffer(gl.FRAMEBUFFER, gl.STENCIL_ATTACHMENT, gl.RENDERBUFFER, gl.Renderbuffer{})
+				glctx.DeleteRenderbuffer(rt.rbo)
+				rt.rbo = gl.Renderbuffer{}
+			}
+		} else {
+			glctx.BindFramebuffer(gl.FRAMEBUFFER, rt.fbo)
+		}
+		glctx.Viewport(0, 0, rt.width, rt.height)
+		c := toFloatColor(rt.clearColor)
+		glctx.ClearColor(c[0], c[1], c[2], c[3])
+		glctx.Clear(gl.COLOR_BUFFER_BIT)
+
+		sz := size.Event{
+			WidthPx:     rt.width,
+			HeightPx:    rt.height,
+			WidthPt:     geom.Pt(rt.width),
+			HeightPt:    geom.Pt(rt.height),
+			PixelsPerPt: 1,
+		}
+		// row 0 of texture is top of image while row 0 of framebuffer is bottom
+		eng.flipY = true
+		eng.skipArrange = true
+		eng.stencil = rt.stencil
+		eng.stencilMask = nil
+		for _, zn := range glpeer.targetNodes(rt) {
+			*eng.nodes[zn.Node.EngineFields.Index] = *glpeer.targetState(rt, zn.sprite)
+			eng.SetPieces(zn.Node, zn.texturePieces())
+			eng.SetTransform(zn.Node, targetAffine(rt, zn))
+			// transform and state for the screen are set again at apply
+			zn.transform.applied = 0
+			zn.state.invalidate()
+			eng.Render(zn.Node, now, sz)
+		}
+		eng.flush()
+		eng.flipY = false
+		eng.skipArrange = false
+		eng.stencil = eng.screenStencil
+		eng.stencilMask = nil
+		glctx.Disable(gl.SCISSOR_TEST)
+
+		// read back to CPU
+		pix := make([]byte, rt.width*rt.height*4)
+		glctx.ReadPixels(pix, 0, 0, rt.width, rt.height, gl.RGBA, gl.UNSIGNED_BYTE)
+		dst := rt.Image()
+		for y := 0; y < rt.height; y++ {
+			copy(dst.Pix[y*dst.Stride:y*dst.Stride+rt.width*4], pix[y*rt.width*4:])
+		}
+
+		glctx.BindFramebuffer(gl.FRAMEBUFFER, current)
+		glctx.Viewport(0, 0, screensize.sz.WidthPx, screensize.sz.HeightPx)
+	}
+}
@@ -0,0 +1,162 @@
+package peer
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"golang.org/x/mobile/exp/sprite"
+)
+
+var (
+	red   = color.RGBA{R: 0xff, A: 0xff}
+	blue  = color.RGBA{B: 0xff, A: 0xff}
+	black = color.RGBA{A: 0xff}
+)
+
+// newTargetTestNode returns a node of sprite whose texture has red top row and blue bottom row
+func newTargetTestNode(s *Sprite) *ZNode {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	img.SetRGBA(0, 0, red)
+	img.SetRGBA(1, 0, red)
+	img.SetRGBA(0, 1, blue)
+	img.SetRGBA(1, 1, blue)
+	tex := &texture{rgba: img, b: img.Bounds(), width: 2, height: 2}
+	n := &sprite.Node{}
+	n.EngineFields.SubTex = sprite.SubTex{T: tex, R: img.Bounds()}
+	return &ZNode{Node: n, sprite: s}
+}
+
+func TestBlendPixel(t *testing.T) {
+	src := [4]float32{0.5, 0, 0, 0.5}
+	dst := [4]float32{0, 0, 1, 1}
+	tcs := []struct {
+		mode BlendMode
+		want [4]float32
+	}{
+		{mode: BlendNormal, want: [4]float32{0.5, 0, 0.5, 1}},
+		{mode: BlendAdditive, want: [4]float32{0.5, 0, 1, 1.5}},
+		{mode: BlendMultiply, want: [4]float32{0, 0, 0.5, 1}},
+		{mode: BlendScreen, want: [4]float32{0.5, 0, 1, 1}},
+	}
+	for i, tc := range tcs {
+		got := blendPixel(tc.mode, src, dst)
+		if got != tc.want {
+			t.Errorf("[%d] unexpected result. [got] %v [want] %v", i, got, tc.want)
+		}
+	}
+}
+
+func TestRenderTargetSoftware(t *testing.T) {
+	glpeer := &GLPeer{}
+	ui, _ := glpeer.AddLayer("ui", 10)
+	s := &Sprite{X: 2, Y: 2, W: 2, H: 2}
+	other := &Sprite{X: 2, Y: 2, W: 4, H: 4}
+	other.SetLayer(ui)
+
+	glpeer.znodes = ZNodes{newTargetTestNode(s), newTargetTestNode(other)}
+	glpeer.layoutDirty = true
+
+	rt, err := glpeer.NewRenderTarget(4, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt.SetClearColor(black)
+	rt.AddSprite(s)
+	glpeer.RenderTo(rt)
+
+	img := rt.Image()
+	tcs := []struct {
+		x, y int
+		want color.RGBA
+	}{
+		// sprite covers (1, 1)-(3, 3), and top of texture is drawn at top
+		{x: 1, y: 1, want: red},
+		{x: 2, y: 1, want: red},
+		{x: 1, y: 2, want: blue},
+		{x: 2, y: 2, want: blue},
+		{x: 0, y: 0, want: black},
+		{x: 3, y: 3, want: black},
+	}
+	for i, tc := range tcs {
+		if got := img.RGBAAt(tc.x, tc.y); got != tc.want {
+			t.Errorf("[%d] unexpected result. [got] %v [want] %v", i, got, tc.want)
+		}
+	}
+
+	// render whole layer to half size. sprite covers bottom left quarter.
+	rt.ClearSources()
+	rt.AddLayer(ui)
+	rt.SetSourceRect(0, 0, 8, 8)
+	glpeer.RenderTo(rt)
+	if got := img.RGBAAt(0, 2); got != red {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, red)
+	}
+	if got := img.RGBAAt(1, 3); got != blue {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, blue)
+	}
+	if got := img.RGBAAt(2, 1); got != black {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, black)
+	}
+}
+
+func TestRenderTargetHiddenSprite(t *testing.T) {
+	s := &Sprite{X: 2, Y: 2, W: 4, H: 4}
+	s.SetAlpha(0.5)
+
+	glpeer := &GLPeer{}
+	glpeer.znodes = ZNodes{newTargetTestNode(s)}
+	glpeer.layoutDirty = true
+
+	rt, err := glpeer.NewRenderTarget(4, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt.AddSprite(s)
+	glpeer.RenderTo(rt)
+	want := color.RGBA{R: 0x80, A: 0x80}
+	if got := rt.Image().RGBAAt(0, 0); got != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+
+	s.SetVisible(false)
+	glpeer.RenderTo(rt)
+	if got := rt.Image().RGBAAt(0, 0); got != (color.RGBA{}) {
+		t.Errorf("hidden sprite should not be rendered. [got] %v", got)
+	}
+}
+
+func TestNewRenderTargetInvalidSize(t *testing.T) {
+	glpeer := &GLPeer{}
+	tcs := []struct {
+		width, height int
+	}{
+		{0, 4}, {4, 0}, {-1, 4}, {4, -1},
+	}
+	for _, tc := range tcs {
+		rt, err := glpeer.NewRenderTarget(tc.width, tc.height)
+		if err == nil || rt != nil {
+			t.Errorf("unexpected result. [got] %v, %v [want] error for %d x %d", rt, err, tc.width, tc.height)
+		}
+	}
+	if len(glpeer.targets) != 0 {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(glpeer.targets), 0)
+	}
+}
+
+func TestNewRenderTargetLoadFailure(t *testing.T) {
+	glpeer, glctx := newImageTestPeer(t)
+	glpeer.glc = &GLContext{glcontext: glctx}
+	glctx.noTexture = true
+
+	rt, err := glpeer.NewRenderTarget(4, 4)
+	if err == nil || rt != nil {
+		t.Errorf("unexpected result. [got] %v, %v [want] error", rt, err)
+	}
+	if len(glpeer.targets) != 0 {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(glpeer.targets), 0)
+	}
+	if len(glpeer.eng.textures) != 0 {
+		t.Errorf("failed texture should not be kept. [got] %d", len(glpeer.eng.textures))
+	}
+}
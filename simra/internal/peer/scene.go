@@ -0,0 +1,52 @@
+package peer
+
+import "sync"
+
+// scene holds layers, viewports and the main camera of GLPeer.
+// Sprites refer to the scene of GLPeer they are added to.
+type scene struct {
+	mu sync.Mutex
+	// layers are layers from back to front
+	layers       []*Layer
+	defaultLayer *Layer
+	// version is incremented when sprites should be regrouped by layers
+	version   int
+	viewports []*Viewport
+	// camera is the main camera
+	camera *Camera
+	// defaultViewport is used while no viewport is added.
+	// It is not clipped, and shows the world through the main camera.
+	defaultViewport *Viewport
+}
+
+// newScene returns a scene that has only the default layer and no viewport
+func newScene() *scene {
+	sc := &scene{camera: &Camera{}}
+	sc.defaultLayer = &Layer{name: DefaultLayerName, scene: sc}
+	sc.layers = []*Layer{sc.defaultLayer}
+	sc.defaultViewport = &Viewport{camera: sc.camera, layerMask: ^uint32(0)}
+	return sc
+}
+
+// getScene returns the scene of GLPeer
+func (glpeer *GLPeer) getScene() *scene {
+	glpeer.sceneOnce.Do(func() {
+		if glpeer.scene == nil {
+			glpeer.scene = newScene()
+		}
+	})
+	return glpeer.scene
+}
+
+// getScene returns the scene that sprite belongs to.
+// Sprite not added to GLPeer has a scene of its own.
+func (s *Sprite) getScene() *scene {
+	if s.scene != nil {
+		return s.scene
+	}
+	if s.layer != nil {
+		return s.layer.scene
+	}
+	s.scene = newScene()
+	return s.scene
+}
@@ -0,0 +1,144 @@
+package peer
+
+import (
+	"fmt"
+
+	"golang.org/x/mobile/exp/gl/glutil"
+	"golang.org/x/mobile/gl"
+)
+
+// shaderProgram represents a compiled program and locations of its variables
+type shaderProgram struct {
+	program gl.Program
+	pos     gl.Attrib
+	inUV    gl.Attrib
+	mvp     gl.Uniform
+	uvp     gl.Uniform
+	tint    gl.Uniform
+	sample  gl.Uniform
+	// uniforms for effects
+	texelSize gl.Uniform
+	uvRect    gl.Uniform
+	// uniforms for masking
+	alphaThreshold gl.Uniform
+	maskEnabled    gl.Uniform
+	maskInv        gl.Uniform
+	maskUVP        gl.Uniform
+	maskThreshold  gl.Uniform
+	maskSample     gl.Uniform
+	// uniforms are locations of uniforms declared by material
+	uniforms map[string]gl.Uniform
+}
+
+// newShaderProgram compiles a program that has specified effect function
+func newShaderProgram(glctx gl.Context, effect string) (*shaderProgram, error) {
+	program, err := glutil.CreateProgram(glctx, vertexShader, fragmentShader(effect))
+	if err != nil {
+		return nil, err
+	}
+	return &shaderProgram{
+		program:        program,
+		pos:            glctx.GetAttribLocation(program, "pos"),
+		inUV:           glctx.GetAttribLocation(program, "inUV"),
+		mvp:            glctx.GetUniformLocation(program, "mvp"),
+		uvp:            glctx.GetUniformLocation(program, "uvp"),
+		tint:           glctx.GetUniformLocation(program, "tint"),
+		sample:         glctx.GetUniformLocation(program, "textureSample"),
+		texelSize:      glctx.GetUniformLocation(program, "texelSize"),
+		uvRect:         glctx.GetUniformLocation(program, "uvRect"),
+		alphaThreshold: glctx.GetUniformLocation(program, "alphaThreshold"),
+		maskEnabled:    glctx.GetUniformLocation(program, "maskEnabled"),
+		maskInv:        glctx.GetUniformLocation(program, "maskInv"),
+		maskUVP:        glctx.GetUniformLocation(program, "maskUVP"),
+		maskThreshold:  glctx.GetUniformLocation(program, "maskThreshold"),
+		maskSample:     glctx.GetUniformLocation(program, "maskSample"),
+		uniforms:       make(map[string]gl.Uniform),
+	}, nil
+}
+
+// uniform returns location of specified uniform declared by material
+func (p *shaderProgram) uniform(glctx gl.Context, name string) gl.Uniform {
+	u, ok := p.uniforms[name]
+	if !ok {
+		u = glctx.GetUniformLocation(p.program, name)
+		p.uniforms[name] = u
+	}
+	return u
+}
+
+const vertexShader = `#version 100
+uniform mat3 mvp;
+uniform mat3 uvp;
+uniform mat3 maskInv;
+attribute vec3 pos;
+attribute vec2 inUV;
+varying vec2 UV;
+varying vec2 maskPos;
+void main() {
+	vec3 p = pos;
+	p.z = 1.0;
+	vec3 clip = mvp * p;
+	gl_Position = vec4(clip, 1);
+	UV = (uvp * vec3(inUV, 1)).xy;
+	maskPos = (maskInv * vec3(clip.xy, 1)).xy;
+}
+`
+
+// defaultEffect draws texture as it is
+const defaultEffect = `
+vec4 effect(sampler2D tex, vec2 uv) {
+	return texture2D(tex, uv);
+}
+`
+
+// fragmentShader returns source of fragment shader that has specified effect function.
+// Effect function is declared as "vec4 effect(sampler2D tex, vec2 uv)",
+// and returns premultiplied color at uv of tex.
+func fragmentShader(effect string) string {
+	return fmt.Sprintf(`#version 100
+precision mediump float;
+varying vec2 UV;
+varying vec2 maskPos;
+uniform sampler2D textureSample;
+uniform vec4 tint;
+uniform float alphaThreshold;
+uniform float maskEnabled;
+uniform mat3 maskUVP;
+uniform float maskThreshold;
+uniform sampler2D maskSample;
+
+// texelSize is size of a texel in texture coordinates
+uniform vec2 texelSize;
+// uvRect is sub rectangle of texture drawn by sprite. (min u, min v, max u, max v)
+uniform vec4 uvRect;
+
+// toUnit converts texture coordinates to 0..1 of sub rectangle
+vec2 toUnit(vec2 uv) {
+	return (uv - uvRect.xy) / (uvRect.zw - uvRect.xy);
+}
+
+// sampleRect returns transparent outside of sub rectangle
+vec4 sampleRect(sampler2D tex, vec2 uv) {
+	if (uv.x < uvRect.x || uv.x > uvRect.z || uv.y < uvRect.y || uv.y > uvRect.w) {
+		return vec4(0.0);
+	}
+	return texture2D(tex, uv);
+}
+%s
+void main() {
+	if (texture2D(textureSample, UV).a < alphaThreshold) {
+		discard;
+	}
+	if (maskEnabled > 0.5) {
+		if (maskPos.x < 0.0 || maskPos.x > 1.0 || maskPos.y < 0.0 || maskPos.y > 1.0) {
+			discard;
+		}
+		vec2 maskUV = (maskUVP * vec3(maskPos, 1)).xy;
+		if (texture2D(maskSample, maskUV).a < maskThreshold) {
+			discard;
+		}
+	}
+	gl_FragColor = effect(textureSample, UV) * tint;
+}
+`, effect)
+}
@@ -0,0 +1,286 @@
+package peer
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+
+	"github.com/pankona/gomo-simra/simra/simlog"
+	"golang.org/x/image/vector"
+)
+
+// shapeTolerance is maximum distance in units between curves and the
+// segments that approximate them
+const shapeTolerance = 0.1
+
+// Shape represents a vector shape in sprite-local coordinates,
+// whose origin is the position of sprite and whose y axis goes upward.
+// Curves are approximated by polygons.
+type Shape struct {
+	// Fill is color of inside of the shape. Open polylines are not filled.
+	Fill color.RGBA
+	// Stroke is color of outline of the shape
+	Stroke color.RGBA
+	// StrokeWidth is width of outline in units. Outline is not drawn if 0.
+	StrokeWidth float32
+	// points are vertices of the outline as x, y pairs
+	points []float32
+	// closed is true if the last point is connected to the first one
+	closed bool
+}
+
+// SetRect makes the shape a rectangle of w x h centered at the origin.
+// Corners are rounded by radius if it is positive.
+func (sh *Shape) SetRect(w, h, radius float32) {
+	hw, hh := w/2, h/2
+	if radius > hw {
+		radius = hw
+	}
+	if radius > hh {
+		radius = hh
+	}
+	sh.points = sh.points[:0]
+	sh.closed = true
+	if radius <= 0 {
+		sh.points = append(sh.points, -hw, hh, hw, hh, hw, -hh, -hw, -hh)
+		return
+	}
+	// arcs of corners from top left, clockwise
+	corners := [4][3]float32{
+		{-hw + radius, hh - radius, math.Pi},
+		{hw - radius, hh - radius, math.Pi / 2},
+		{hw - radius, -hh + radius, 0},
+		{-hw + radius, -hh + radius, -math.Pi / 2},
+	}
+	n := arcSegments(radius, radius, math.Pi/2)
+	for _, c := range corners {
+		for i := 0; i <= n; i++ {
+			a := float64(c[2]) - math.Pi/2*float64(i)/float64(n)
+			sh.points = append(sh.points,
+				c[0]+radius*float32(math.Cos(a)),
+				c[1]+radius*float32(math.Sin(a)))
+		}
+	}
+}
+
+// SetEllipse makes the shape an ellipse centered at the origin,
+// whose radii are rx and ry
+func (sh *Shape) SetEllipse(rx, ry float32) {
+	sh.points = sh.points[:0]
+	sh.closed = true
+	n := arcSegments(rx, ry, 2*math.Pi)
+	for i := 0; i < n; i++ {
+		a := -2 * math.Pi * float64(i) / float64(n)
+		sh.points = append(sh.points, rx*float32(math.Cos(a)), ry*float32(math.Sin(a)))
+	}
+}
+
+// SetPolyline makes the shape segments connecting points, that are x, y pairs.
+// The last point is connected to the first one if closed.
+func (sh *Shape) SetPolyline(points []float32, closed bool) {
+	sh.points = append(sh.points[:0], points[:len(points)/2*2]...)
+	sh.closed = closed
+}
+
+// arcSegments returns the number of segments that approximate an arc of
+// ellipse whose radii are rx and ry within shapeTolerance
+func arcSegments(rx, ry float32, angle float64) int {
+	r := float64(rx)
+	if float64(ry) > r {
+		r = float64(ry)
+	}
+	if r <= shapeTolerance {
+		return 4
+	}
+	step := 2 * math.Acos(1-shapeTolerance/r)
+	n := int(math.Ceil(angle / step))
+	if n < 4 {
+		n = 4
+	}
+	return n
+}
+
+// shapePlacement represents where the shape is rasterized
+type shapePlacement struct {
+	// image is the area of the rasterized image in the canvas in pixels
+	image image.Rectangle
+	// canvas is size of the area in pixels that is centered at the origin
+	// and contains the shape
+	canvas image.Point
+	// ppu is the number of pixels per unit
+	ppu float32
+}
+
+// place returns where the shape is rasterized with ppu pixels per unit.
+// ok is false if the shape draws nothing.
+func (sh *Shape) place(ppu float32) (p shapePlacement, ok bool) {
+	if len(sh.points) == 0 {
+		return p, false
+	}
+	minX, minY := sh.points[0], sh.points[1]
+	maxX, maxY := minX, minY
+	for i := 2; i < len(sh.points); i += 2 {
+		x, y := sh.points[i], sh.points[i+1]
+		minX, maxX = float32(math.Min(float64(minX), float64(x))), float32(math.Max(float64(maxX), float64(x)))
+		minY, maxY = float32(math.Min(float64(minY), float64(y))), float32(math.Max(float64(maxY), float64(y)))
+	}
+	// outline is drawn on both sides of the edges
+	m := sh.StrokeWidth / 2
+	minX, minY, maxX, maxY = minX-m, minY-m, maxX+m, maxY+m
+
+	// the origin is at center of the canvas, placed on a pixel boundary
+	ex := int(math.Ceil(float64(ppu * float32(math.Max(math.Abs(float64(minX)), math.Abs(float64(maxX)))))))
+	ey := int(math.Ceil(float64(ppu * float32(math.Max(math.Abs(float64(minY)), math.Abs(float64(maxY)))))))
+	p = shapePlacement{
+		image: image.Rect(
+			int(math.Floor(float64(minX*ppu)))+ex,
+			ey-int(math.Ceil(float64(maxY*ppu))),
+			int(math.Ceil(float64(maxX*ppu)))+ex,
+			ey-int(math.Floor(float64(minY*ppu))),
+		),
+		canvas: image.Pt(2*ex, 2*ey),
+		ppu:    ppu,
+	}
+	return p, !p.image.Empty()
+}
+
+// rasterize draws the shape into an image of the placement
+func (sh *Shape) rasterize(p shapePlacement) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, p.image.Dx(), p.image.Dy()))
+	// pixel position of points in dst
+	pts := make([]float32, len(sh.points))
+	for i := 0; i < len(pts); i += 2 {
+		pts[i] = sh.points[i]*p.ppu + float32(p.canvas.X/2-p.image.Min.X)
+		pts[i+1] = float32(p.canvas.Y/2-p.image.Min.Y) - sh.points[i+1]*p.ppu
+	}
+	mask := image.NewAlpha(dst.Bounds())
+	z := vector.NewRasterizer(0, 0)
+
+	if sh.closed && sh.Fill.A > 0 && len(pts) >= 6 {
+		fillPolygon(z, mask, pts)
+		draw.DrawMask(dst, dst.Bounds(), image.NewUniform(sh.Fill), image.Point{}, mask, image.Point{}, draw.Over)
+	}
+	if sh.StrokeWidth > 0 && sh.Stroke.A > 0 {
+		draw.Draw(mask, mask.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		strokePolyline(z, mask, pts, sh.closed, sh.StrokeWidth*p.ppu/2)
+		draw.DrawMask(dst, dst.Bounds(), image.NewUniform(sh.Stroke), image.Point{}, mask, image.Point{}, draw.Over)
+	}
+	return dst
+}
+
+// fillPolygon accumulates coverage of the polygon into mask
+func fillPolygon(z *vector.Rasterizer, mask *image.Alpha, pts []float32) {
+	minX, minY := pts[0], pts[1]
+	maxX, maxY := minX, minY
+	for i := 2; i < len(pts); i += 2 {
+		minX, maxX = float32(math.Min(float64(minX), float64(pts[i]))), float32(math.Max(float64(maxX), float64(pts[i])))
+		minY, maxY = float32(math.Min(float64(minY), float64(pts[i+1]))), float32(math.Max(float64(maxY), float64(pts[i+1])))
+	}
+	// rasterize only the bounding box of the polygon
+	r := image.Rect(
+		int(math.Floor(float64(minX))), int(math.Floor(float64(minY))),
+		int(math.Ceil(float64(maxX))), int(math.Ceil(float64(maxY))),
+	).Intersect(mask.Bounds())
+	if r.Empty() {
+		return
+	}
+	ox, oy := float32(r.Min.X), float32(r.Min.Y)
+	z.Reset(r.Dx(), r.Dy())
+	z.MoveTo(pts[0]-ox, pts[1]-oy)
+	for i := 2; i < len(pts); i += 2 {
+		z.LineTo(pts[i]-ox, pts[i+1]-oy)
+	}
+	z.ClosePath()
+	// pieces are composited by over operator, so that overlapped pieces
+	// don't cancel each other regardless of their direction
+	z.Draw(mask, r, image.Opaque, image.Point{})
+}
+
+// strokePolyline accumulates coverage of the outline of the polyline, whose
+// half width is hw, into mask. Segments are connected by round joins.
+func strokePolyline(z *vector.Rasterizer, mask *image.Alpha, pts []float32, closed bool, hw float32) {
+	n := len(pts) / 2
+	if n == 1 {
+		fillCircle(z, mask, pts[0], pts[1], hw)
+		return
+	}
+	segments := n - 1
+	if closed {
+		segments = n
+	}
+	for i := 0; i < segments; i++ {
+		j := (i + 1) % n
+		ax, ay, bx, by := pts[i*2], pts[i*2+1], pts[j*2], pts[j*2+1]
+		dx, dy := bx-ax, by-ay
+		l := float32(math.Hypot(float64(dx), float64(dy)))
+		if l == 0 {
+			continue
+		}
+		// normal of the segment whose length is hw
+		nx, ny := -dy/l*hw, dx/l*hw
+		fillPolygon(z, mask, []float32{
+			ax + nx, ay + ny,
+			bx + nx, by + ny,
+			bx - nx, by - ny,
+			ax - nx, ay - ny,
+		})
+	}
+	// ends of open polyline are butt
+	for i := 0; i < n; i++ {
+		if !closed && (i == 0 || i == n-1) {
+			continue
+		}
+		fillCircle(z, mask, pts[i*2], pts[i*2+1], hw)
+	}
+}
+
+// fillCircle accumulates coverage of a circle into mask
+func fillCircle(z *vector.Rasterizer, mask *image.Alpha, cx, cy, r float32) {
+	n := arcSegments(r, r, 2*math.Pi)
+	pts := make([]float32, 0, n*2)
+	for i := 0; i < n; i++ {
+		a := 2 * math.Pi * float64(i) / float64(n)
+		pts = append(pts, cx+r*float32(math.Cos(a)), cy+r*float32(math.Sin(a)))
+	}
+	fillPolygon(z, mask, pts)
+}
+
+// DrawShape rasterizes the shape with ppu pixels per unit. The pixels are
+// drawn into t if it has the same placement, otherwise a new texture is
+// returned. t can be nil. w and h are size of the sprite that shows the
+// texture, whose center is the origin of the shape.
+func (glpeer *GLPeer) DrawShape(t *Texture, sh *Shape, ppu float32) (tex *Texture, w, h float32) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	p, ok := sh.place(ppu)
+	if !ok {
+		return nil, 0, 0
+	}
+	img := sh.rasterize(p)
+	w, h = float32(p.canvas.X)/ppu, float32(p.canvas.Y)/ppu
+	source := image.Rectangle{Max: p.canvas}.Sub(p.image.Min)
+
+	// the texture is restored by rasterizing the shape again
+	c := *sh
+	c.points = append([]float32(nil), sh.points...)
+	reload := func() (image.Image, error) {
+		return c.rasterize(p), nil
+	}
+
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+
+	if t != nil && !t.released && t.source == source && t.subTex.R == img.Bounds() {
+		if st, ok := t.subTex.T.(*texture); ok {
+			st.reload = reload
+			st.draw(img.Bounds(), img, image.Point{})
+			return t, w, h
+		}
+	}
+	tex = glpeer.newTextureFromImage(img, reload, "shape")
+	// canvas is placed around the image like a trimmed frame of an atlas
+	tex.source = source
+	return tex, w, h
+}
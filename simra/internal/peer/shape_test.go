@@ -0,0 +1,120 @@
+package peer
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestShapeRasterize(t *testing.T) {
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+	tcs := []struct {
+		name   string
+		setup  func(sh *Shape)
+		ppu    float32
+		image  image.Rectangle
+		canvas image.Point
+		pixels map[image.Point]color.RGBA
+	}{
+		{
+			name:   "rect",
+			setup:  func(sh *Shape) { sh.SetRect(10, 6, 0) },
+			ppu:    2,
+			image:  image.Rect(0, 0, 20, 12),
+			canvas: image.Pt(20, 12),
+			pixels: map[image.Point]color.RGBA{{0, 0}: red, {19, 11}: red},
+		},
+		{
+			name: "stroked rect",
+			setup: func(sh *Shape) {
+				sh.SetRect(10, 6, 0)
+				sh.StrokeWidth = 2
+			},
+			ppu:    1,
+			image:  image.Rect(0, 0, 12, 8),
+			canvas: image.Pt(12, 8),
+			pixels: map[image.Point]color.RGBA{{0, 4}: blue, {11, 4}: blue, {6, 4}: red},
+		},
+		{
+			name:   "circle",
+			setup:  func(sh *Shape) { sh.SetEllipse(5, 5) },
+			ppu:    1,
+			image:  image.Rect(0, 0, 10, 10),
+			canvas: image.Pt(10, 10),
+			pixels: map[image.Point]color.RGBA{{0, 0}: {}, {9, 9}: {}, {5, 5}: red},
+		},
+		{
+			// line placed right of the origin
+			name: "line",
+			setup: func(sh *Shape) {
+				sh.SetPolyline([]float32{0, 0, 10, 0}, false)
+				sh.StrokeWidth = 2
+			},
+			ppu:    1,
+			image:  image.Rect(10, 0, 22, 2),
+			canvas: image.Pt(22, 2),
+			pixels: map[image.Point]color.RGBA{{0, 0}: {}, {5, 0}: blue, {5, 1}: blue},
+		},
+	}
+	for _, tc := range tcs {
+		sh := &Shape{Fill: red, Stroke: blue}
+		tc.setup(sh)
+		p, ok := sh.place(tc.ppu)
+		if !ok {
+			t.Fatalf("[%s] shape should be placed", tc.name)
+		}
+		if p.image != tc.image || p.canvas != tc.canvas {
+			t.Errorf("[%s] unexpected result. [got] %v, %v [want] %v, %v", tc.name, p.image, p.canvas, tc.image, tc.canvas)
+		}
+		img := sh.rasterize(p)
+		for pt, want := range tc.pixels {
+			if got := img.RGBAAt(pt.X, pt.Y); got != want {
+				t.Errorf("[%s] unexpected result at %v. [got] %v [want] %v", tc.name, pt, got, want)
+			}
+		}
+	}
+
+	if _, ok := (&Shape{}).place(1); ok {
+		t.Errorf("empty shape should not be placed")
+	}
+}
+
+func TestDrawShape(t *testing.T) {
+	glpeer, glctx := newImageTestPeer(t)
+	sh := &Shape{Fill: color.RGBA{R: 255, A: 255}}
+	sh.SetPolyline([]float32{0, 0, 4, 0, 4, 2}, true)
+
+	tex, w, h := glpeer.DrawShape(nil, sh, 1)
+	if w != 8 || h != 4 {
+		t.Errorf("unexpected result. [got] %f, %f [want] %f, %f", w, h, 8.0, 4.0)
+	}
+	if want := image.Rect(-4, 0, 4, 4); tex.source != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", tex.source, want)
+	}
+
+	// change of style is drawn into the same texture
+	green := color.RGBA{G: 255, A: 255}
+	sh.Fill = green
+	got, _, _ := glpeer.DrawShape(tex, sh, 1)
+	if got != tex {
+		t.Errorf("texture should be reused")
+	}
+	st := tex.subTex.T.(*texture)
+	if st.rgba != nil {
+		t.Errorf("pixels should not be kept on CPU")
+	}
+	// pixels are reproduced by rasterizing the shape again
+	if c := st.pixels().RGBAAt(3, 1); c != green {
+		t.Errorf("unexpected result. [got] %v [want] %v", c, green)
+	}
+	if c := glctx.textures[st.gltex.Value][st.width*4+3*4+1]; c != 255 {
+		t.Errorf("pixels should be uploaded. [got] %d [want] %d", c, 255)
+	}
+
+	// change of size makes a new texture
+	sh.SetPolyline([]float32{0, 0, 8, 0, 8, 2}, true)
+	if got, _, _ := glpeer.DrawShape(tex, sh, 1); got == tex {
+		t.Errorf("new texture should be made")
+	}
+}
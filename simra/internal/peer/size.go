@@ -3,6 +3,7 @@ package peer
 import (
 	"github.com/pankona/gomo-simra/simra/simlog"
 	"golang.org/x/mobile/event/size"
+	"golang.org/x/mobile/exp/f32"
 )
 
 // ScreenSizer represents interface for configurating screen size
@@ -72,3 +73,38 @@ func (ss *screenSize) calcScale() {
 		ss.marginHeight = float32(ss.sz.HeightPt) - h*ss.scale
 	}
 }
+
+// calcVirtualPosition converts specified position in device pixels
+// to the position in virtual screen coordinates.
+func (ss *screenSize) calcVirtualPosition(pxx, pxy float32) (float32, float32) {
+	ptx := pxx / ss.sz.PixelsPerPt
+	pty := pxy / ss.sz.PixelsPerPt
+
+	var scale float32
+	if ss.fitTo == fitHeight {
+		scale = ss.height / float32(ss.sz.HeightPt)
+	} else {
+		scale = ss.width / float32(ss.sz.WidthPt)
+	}
+
+	return (ptx - ss.marginWidth/2) * scale,
+		ss.height - (pty-ss.marginHeight/2)*scale
+}
+
+// screenTransform returns an affine that maps virtual screen coordinates
+// to device's screen coordinates in pt, whose y axis goes downward.
+func (ss *screenSize) screenTransform() f32.Affine {
+	return f32.Affine{
+		{ss.scale, 0, ss.marginWidth / 2},
+		{0, -ss.scale, ss.height*ss.scale + ss.marginHeight/2},
+	}
+}
+
+// scissorRect converts specified rectangle in virtual screen coordinates
+// to a rectangle in device pixels for glScissor, whose origin is bottom left.
+func (ss *screenSize) scissorRect(x, y, w, h float32) (int32, int32, int32, int32) {
+	ppp := ss.sz.PixelsPerPt
+	left := (x*ss.scale + ss.marginWidth/2) * ppp
+	bottom := float32(ss.sz.HeightPx) - ((ss.height-y)*ss.scale+ss.marginHeight/2)*ppp
+	return int32(left + 0.5), int32(bottom + 0.5), int32(w*ss.scale*ppp + 0.5), int32(h*ss.scale*ppp + 0.5)
+}
@@ -0,0 +1,135 @@
+package peer
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"golang.org/x/mobile/exp/f32"
+)
+
+// drawSoftware draws sub rectangle r of texture t into dst on CPU, as engine does on GPU.
+// uv maps the unit square to the area drawn in r. nil draws whole r.
+// m maps the unit square (0..1) to dst in pixels, whose origin is top left.
+// Color, blend mode, clipping, mask and material are taken from ns.
+// Material is applied by its reference implementation if it has.
+// Scissor box of ns is regarded as a rectangle of dst.
+// Texels are sampled by nearest neighbor.
+func drawSoftware(dst *image.RGBA, t *texture, r image.Rectangle, uv, m *f32.Affine, ns *nodeState) {
+	if !invertible(m) || ns.tint[3] <= 0 {
+		return
+	}
+	var inv f32.Affine
+	inv.Inverse(m)
+	var maskInv f32.Affine
+	if ns.mask != nil {
+		if !invertible(&ns.mask.m) {
+			return
+		}
+		maskInv.Inverse(&ns.mask.m)
+	}
+
+	// bounding box of the parallelogram
+	minX, minY := float32(math.Inf(1)), float32(math.Inf(1))
+	maxX, maxY := float32(math.Inf(-1)), float32(math.Inf(-1))
+	for _, c := range [][2]float32{{0, 0}, {1, 0}, {0, 1}, {1, 1}} {
+		x := m[0][0]*c[0] + m[0][1]*c[1] + m[0][2]
+		y := m[1][0]*c[0] + m[1][1]*c[1] + m[1][2]
+		minX, maxX = float32(math.Min(float64(minX), float64(x))), float32(math.Max(float64(maxX), float64(x)))
+		minY, maxY = float32(math.Min(float64(minY), float64(y))), float32(math.Max(float64(maxY), float64(y)))
+	}
+	box := image.Rect(
+		int(math.Floor(float64(minX))), int(math.Floor(float64(minY))),
+		int(math.Ceil(float64(maxX))), int(math.Ceil(float64(maxY))),
+	).Intersect(dst.Bounds())
+	if ns.clipped {
+		sc := ns.scissor
+		box = box.Intersect(image.Rect(int(sc[0]), int(sc[1]), int(sc[0]+sc[2]), int(sc[1]+sc[3])))
+	}
+
+	for py := box.Min.Y; py < box.Max.Y; py++ {
+		for px := box.Min.X; px < box.Max.X; px++ {
+			// sample at the center of pixel
+			x, y := float32(px)+0.5, float32(py)+0.5
+			u := inv[0][0]*x + inv[0][1]*y + inv[0][2]
+			v := inv[1][0]*x + inv[1][1]*y + inv[1][2]
+			if u < 0 || u >= 1 || v < 0 || v >= 1 {
+				continue
+			}
+			if mk := ns.mask; mk != nil {
+				mu := maskInv[0][0]*x + maskInv[0][1]*y + maskInv[0][2]
+				mv := maskInv[1][0]*x + maskInv[1][1]*y + maskInv[1][2]
+				if mu < 0 || mu >= 1 || mv < 0 || mv >= 1 {
+					continue
+				}
+				if float32(sampleTexel(mk.tex, mk.r, mu, mv).A)/255 < mk.threshold {
+					continue
+				}
+			}
+			if uv != nil {
+				u, v = uv[0][0]*u+uv[0][2], uv[1][1]*v+uv[1][2]
+			}
+			src := toFloatColor(sampleTexel(t, r, u, v))
+			if mt := ns.material; mt != nil && mt.reference != nil {
+				src = mt.reference(mt, sampler{t, r}, u, v)
+			}
+			for i := range src {
+				src[i] *= ns.tint[i]
+			}
+			dc := dst.RGBAAt(px, py)
+			dst.SetRGBA(px, py, toRGBA(blendPixel(ns.blend, src, toFloatColor(dc))))
+		}
+	}
+}
+
+func invertible(m *f32.Affine) bool {
+	return m[0][0]*m[1][1]-m[0][1]*m[1][0] != 0
+}
+
+// sampleTexel returns the texel at (u, v) of the unit square of sub rectangle r
+func sampleTexel(t *texture, r image.Rectangle, u, v float32) color.RGBA {
+	return t.pixels().RGBAAt(
+		r.Min.X+int(u*float32(r.Dx())),
+		r.Min.Y+int(v*float32(r.Dy())))
+}
+
+// blendPixel blends premultiplied color src over dst in the same way as
+// glBlendFunc configured by BlendMode.blendFunc.
+func blendPixel(mode BlendMode, src, dst [4]float32) [4]float32 {
+	var out [4]float32
+	for i := range out {
+		switch mode {
+		case BlendAdditive:
+			out[i] = src[i] + dst[i]
+		case BlendMultiply:
+			out[i] = src[i]*dst[i] + dst[i]*(1-src[3])
+		case BlendScreen:
+			out[i] = src[i] + dst[i]*(1-src[i])
+		default:
+			out[i] = src[i] + dst[i]*(1-src[3])
+		}
+	}
+	return out
+}
+
+func toFloatColor(c color.RGBA) [4]float32 {
+	return [4]float32{
+		float32(c.R) / 255,
+		float32(c.G) / 255,
+		float32(c.B) / 255,
+		float32(c.A) / 255,
+	}
+}
+
+func toRGBA(c [4]float32) color.RGBA {
+	var v [4]uint8
+	for i, f := range c {
+		if f < 0 {
+			f = 0
+		} else if f > 1 {
+			f = 1
+		}
+		v[i] = uint8(f*255 + 0.5)
+	}
+	return color.RGBA{v[0], v[1], v[2], v[3]}
+}
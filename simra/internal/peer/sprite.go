@@ -1,13 +1,101 @@
 package peer
 
-import "github.com/pankona/gomo-simra/simra/simlog"
+import (
+	"image/color"
+
+	"github.com/pankona/gomo-simra/simra/simlog"
+)
 
 // Spriter represents interface of Sprite
 type Spriter interface {
 	// AddTouchListener registers a listener to notify touch event.
 	AddTouchListener(l TouchListener)
+	// RemoveTouchListener removes specified listener from sprite.
+	RemoveTouchListener(l TouchListener)
 	// RemoveAllTouchListener removes all registered listeners from sprite.
 	RemoveAllTouchListener()
+	// AddPointerListener registers a listener to notify hover event.
+	AddPointerListener(l PointerListener)
+	// RemoveAllPointerListener removes all registered hover listeners from sprite.
+	RemoveAllPointerListener()
+	// SetAlpha sets opacity of sprite. 0 is transparent and 1 is opaque.
+	SetAlpha(alpha float32)
+	// GetAlpha returns opacity of sprite.
+	GetAlpha() float32
+	// SetTint sets color multiplied to sprite's texture.
+	SetTint(tint color.RGBA)
+	// GetTint returns color multiplied to sprite's texture.
+	GetTint() color.RGBA
+	// SetBlendMode sets how sprite is blended with the background.
+	SetBlendMode(mode BlendMode)
+	// GetBlendMode returns how sprite is blended with the background.
+	GetBlendMode() BlendMode
+	// SetFlip sets whether sprite's texture is mirrored horizontally and vertically.
+	SetFlip(x, y bool)
+	// GetFlip returns whether sprite's texture is mirrored horizontally and vertically.
+	GetFlip() (x, y bool)
+	// SetAnchor sets anchor point of sprite in 0..1 of sprite's size.
+	SetAnchor(x, y float32)
+	// GetAnchor returns anchor point of sprite.
+	GetAnchor() (x, y float32)
+	// SetSkew sets skew angles of sprite in radian.
+	SetSkew(x, y float32)
+	// GetSkew returns skew angles of sprite.
+	GetSkew() (x, y float32)
+	// AddChild adds specified sprite as a child.
+	AddChild(child *Sprite) error
+	// RemoveChild detaches specified child from this sprite.
+	RemoveChild(child *Sprite)
+	// GetParent returns parent of this sprite.
+	GetParent() *Sprite
+	// GetChildren returns children of this sprite.
+	GetChildren() []*Sprite
+	// SetScaleFactor sets scale factor of sprite.
+	SetScaleFactor(x, y float32)
+	// GetScaleFactor returns scale factor of sprite.
+	GetScaleFactor() (x, y float32)
+	// SetVisible sets visibility of sprite.
+	SetVisible(visible bool)
+	// IsVisible returns true if sprite and all of its ancestors are visible.
+	IsVisible() bool
+	// SetScreenSpace sets whether sprite is placed in screen space, not affected by camera.
+	SetScreenSpace(screenSpace bool)
+	// IsScreenSpace returns true if sprite is placed in screen space.
+	IsScreenSpace() bool
+	// SetLayerBits sets layer bits of sprite matched with viewport's layer mask.
+	SetLayerBits(bits uint32)
+	// GetLayerBits returns layer bits of sprite.
+	GetLayerBits() uint32
+	// SetLayer sets the layer that sprite belongs to.
+	SetLayer(l *Layer)
+	// GetLayer returns the layer that sprite belongs to.
+	GetLayer() *Layer
+	// SetClipRect clips sprite and its descendants to specified rectangle in virtual screen coordinates.
+	SetClipRect(x, y, w, h float32)
+	// ClearClipRect removes clip rectangle of sprite.
+	ClearClipRect()
+	// GetClipRect returns clip rectangle of sprite.
+	GetClipRect() (x, y, w, h float32, ok bool)
+	// SetMask masks sprite and its descendants by alpha of mask sprite's texture.
+	SetMask(mask *Sprite, threshold uint8)
+	// GetMask returns mask sprite and its threshold.
+	GetMask() (*Sprite, uint8)
+	// SetMaterial sets material that draws sprite.
+	SetMaterial(m *Material)
+	// GetMaterial returns material that draws sprite.
+	GetMaterial() *Material
+	// SetNineSlice draws texture of sprite by nine pieces divided by specified insets.
+	SetNineSlice(ns *NineSlice)
+	// GetNineSlice returns insets of nine-slice drawing.
+	GetNineSlice() *NineSlice
+	// SetTextureWrap repeats texture over sprite as specified.
+	SetTextureWrap(w *TextureWrap)
+	// GetTextureWrap returns repetition of texture over sprite.
+	GetTextureWrap() *TextureWrap
+	// LocalToWorld converts position relative to sprite to virtual screen coordinates.
+	LocalToWorld(x, y float32) (float32, float32)
+	// WorldToLocal converts position in virtual screen coordinates to position relative to sprite.
+	WorldToLocal(x, y float32) (float32, float32)
 }
 
 // NewSprite returns an instance of Spriter
@@ -30,6 +118,57 @@ type Sprite struct {
 	R float32
 	// touchListeners is listeners to notify touch event
 	touchListeners []*TouchListener
+	// pointerListeners is listeners to notify hover event
+	pointerListeners []PointerListener
+	// hitShape is used for hit testing. nil means whole rectangle of sprite.
+	hitShape HitShape
+	// hasColor is false until alpha or tint is specified.
+	// while false, sprite is drawn opaque without tint.
+	hasColor bool
+	// alpha is opacity of sprite
+	alpha float32
+	// tint is color multiplied to texture
+	tint color.RGBA
+	// blend is blend mode of sprite
+	blend BlendMode
+	// flipX and flipY mirror texture of sprite
+	flipX, flipY bool
+	// pivotX and pivotY are anchor point relative to the center of sprite.
+	// zero value means the center.
+	pivotX, pivotY float32
+	// skewX and skewY are skew angles of sprite in radian
+	skewX, skewY float32
+	// scaleX and scaleY are scale factor of sprite minus 1.
+	// zero value means no scaling.
+	scaleX, scaleY float32
+	// hidden is true if sprite is not rendered
+	hidden bool
+	// screenSpace is true if sprite is not affected by camera
+	screenSpace bool
+	// layerBits is layer bits of sprite with inverted lowest bit.
+	// zero value means default, that is 1.
+	layerBits uint32
+	// layer is the layer that sprite belongs to. nil means the default layer.
+	layer *Layer
+	// scene is the scene of GLPeer that sprite is added to
+	scene *scene
+	// parent is parent sprite. nil if sprite is a root.
+	parent *Sprite
+	// children are child sprites
+	children []*Sprite
+	// clip is clip rectangle of sprite. nil if not clipped.
+	clip *clipRect
+	// mask is the sprite whose texture masks this sprite. nil if not masked.
+	mask          *Sprite
+	maskThreshold uint8
+	// material draws sprite. nil means the default drawing.
+	material *Material
+	// nineSlice divides texture of sprite into nine pieces. nil if not nine-sliced.
+	nineSlice *NineSlice
+	// wrap repeats texture over sprite. nil if not repeated.
+	wrap *TextureWrap
+	// owner is the object that wraps sprite. nil if not wrapped.
+	owner interface{}
 }
 
 // AddTouchListener registers a listener to notify touch event.
@@ -39,9 +178,102 @@ func (s *Sprite) AddTouchListener(l TouchListener) {
 	simlog.FuncOut()
 }
 
+// RemoveTouchListener removes specified listener from sprite.
+func (s *Sprite) RemoveTouchListener(l TouchListener) {
+	simlog.FuncIn()
+	var listeners []*TouchListener
+	for _, v := range s.touchListeners {
+		if *v != l {
+			listeners = append(listeners, v)
+		}
+	}
+	s.touchListeners = listeners
+	simlog.FuncOut()
+}
+
 // RemoveAllTouchListener removes all registered listeners from sprite.
 func (s *Sprite) RemoveAllTouchListener() {
 	simlog.FuncIn()
 	s.touchListeners = nil
 	simlog.FuncOut()
 }
+
+// AddPointerListener registers a listener to notify hover event.
+func (s *Sprite) AddPointerListener(l PointerListener) {
+	simlog.FuncIn()
+	s.pointerListeners = append(s.pointerListeners, l)
+	simlog.FuncOut()
+}
+
+// RemoveAllPointerListener removes all registered hover listeners from sprite.
+func (s *Sprite) RemoveAllPointerListener() {
+	simlog.FuncIn()
+	s.pointerListeners = nil
+	simlog.FuncOut()
+}
+
+// Contains returns true if specified position hits the sprite.
+// The position is tested against sprite's HitShape, considering its rotation.
+// Hidden sprite never contains any position.
+func (s *Sprite) Contains(x, y float32) bool {
+	return isContained(s, x, y)
+}
+
+// SetHitShape sets a shape used for hit testing of touch and hover.
+// Specifying nil resets the shape to whole rectangle of sprite.
+func (s *Sprite) SetHitShape(shape HitShape) {
+	s.hitShape = shape
+}
+
+func (s *Sprite) initColor() {
+	if s.hasColor {
+		return
+	}
+	s.alpha = 1
+	s.tint = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	s.hasColor = true
+}
+
+// SetAlpha sets opacity of sprite. 0 is transparent and 1 is opaque.
+func (s *Sprite) SetAlpha(alpha float32) {
+	s.initColor()
+	if alpha < 0 {
+		alpha = 0
+	} else if alpha > 1 {
+		alpha = 1
+	}
+	s.alpha = alpha
+}
+
+// GetAlpha returns opacity of sprite.
+func (s *Sprite) GetAlpha() float32 {
+	if !s.hasColor {
+		return 1
+	}
+	return s.alpha
+}
+
+// SetTint sets color multiplied to sprite's texture.
+// White means no tint. Alpha of tint is multiplied to sprite's alpha.
+func (s *Sprite) SetTint(tint color.RGBA) {
+	s.initColor()
+	s.tint = tint
+}
+
+// GetTint returns color multiplied to sprite's texture.
+func (s *Sprite) GetTint() color.RGBA {
+	if !s.hasColor {
+		return color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	}
+	return s.tint
+}
+
+// SetBlendMode sets how sprite is blended with the background.
+func (s *Sprite) SetBlendMode(mode BlendMode) {
+	s.blend = mode
+}
+
+// GetBlendMode returns how sprite is blended with the background.
+func (s *Sprite) GetBlendMode() BlendMode {
+	return s.blend
+}
@@ -1,6 +1,9 @@
 package peer
 
-import "testing"
+import (
+	"image/color"
+	"testing"
+)
 
 func TestAddRemoveTouchListener(t *testing.T) {
 	s := &Sprite{}
@@ -27,3 +30,53 @@ func TestAddRemoveTouchListener(t *testing.T) {
 		}
 	}
 }
+
+func TestRemoveTouchListener(t *testing.T) {
+	s := &Sprite{}
+	l1 := &listener{}
+	l2 := &listener{}
+	s.AddTouchListener(l1)
+	s.AddTouchListener(l2)
+
+	s.RemoveTouchListener(l1)
+	if len(s.touchListeners) != 1 || *s.touchListeners[0] != l2 {
+		t.Errorf("unexpected result. only l2 should remain")
+	}
+
+	// removing not registered listener does nothing
+	s.RemoveTouchListener(l1)
+	if len(s.touchListeners) != 1 {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(s.touchListeners), 1)
+	}
+}
+
+func TestSpriteColor(t *testing.T) {
+	s := &Sprite{}
+	white := color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+
+	// sprite is opaque without tint by default
+	if s.GetAlpha() != 1 || s.GetTint() != white || s.GetBlendMode() != BlendNormal {
+		t.Errorf("unexpected default. [got] alpha=%f tint=%v blend=%d", s.GetAlpha(), s.GetTint(), s.GetBlendMode())
+	}
+
+	red := color.RGBA{R: 0xff, A: 0xff}
+	s.SetTint(red)
+	if s.GetAlpha() != 1 || s.GetTint() != red {
+		t.Errorf("unexpected result. [got] alpha=%f tint=%v", s.GetAlpha(), s.GetTint())
+	}
+
+	// alpha is clamped
+	s.SetAlpha(1.5)
+	if s.GetAlpha() != 1 {
+		t.Errorf("unexpected result. [got] %f [want] %f", s.GetAlpha(), 1.0)
+	}
+	s.SetAlpha(-1)
+	if s.GetAlpha() != 0 {
+		t.Errorf("unexpected result. [got] %f [want] %f", s.GetAlpha(), 0.0)
+	}
+
+	s.SetBlendMode(BlendAdditive)
+	if s.GetBlendMode() != BlendAdditive {
+		t.Errorf("unexpected result. [got] %d [want] %d", s.GetBlendMode(), BlendAdditive)
+	}
+}
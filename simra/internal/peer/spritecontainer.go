@@ -2,6 +2,7 @@ package peer
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/pankona/gomo-simra/simra/simlog"
@@ -20,7 +21,7 @@ type SpriteContainerer interface {
 	// RemoveSprite removes a specified sprite from SpriteContainer.
 	// Since Unregister of Node is not implemented by gomobile, this function just
 	// marks the specified sprite as "not in use".
-	// The sprite marked as "not in use" will be reused at AddSprite.
+	// The node of the sprite marked as "not in use" will be recycled at AddSprite.
 	RemoveSprite(remove *Sprite)
 	// RemoveSprites removes all registered sprites from SpriteContainer.
 	RemoveSprites()
@@ -28,34 +29,69 @@ type SpriteContainerer interface {
 	SetZIndex(sprite *Sprite, z int) error
 	// GetZIndex returns specified sprite's zindex
 	GetZIndex(sprite *Sprite) (int, error)
-	// GetSpriteNodePairs returns map representation of sprite and node pair
-	GetSpriteNodePairs() *sync.Map
+	// GetSpriteNodePairs returns sprite and node pairs in added order,
+	// including pairs that are not in use.
+	// Textures replaced since the last call are applied to the nodes.
+	GetSpriteNodePairs() []*spriteNodePair
 	// ReplaceTexture replaces sprite's texture to specified one.
+	// It is safe to call from any goroutine. The texture is applied to the
+	// node at the next frame.
 	ReplaceTexture(sprite *Sprite, texture *Texture)
 	// OnTouchBegin is called when screen is started to touch.
 	// This function calls listener's OnTouchBegin if the touched position is
-	// contained by sprite's rectangle.
+	// contained by sprite's rectangle. Sprites are notified from front to back
+	// until a listener consumes the event. Notified sprites capture the pointer.
 	OnTouchBegin(x, y float32)
 	// OnTouchMove is called when touch is moved (dragged).
-	// This function calls listener's OnTouchMove if the touched position is
-	// contained by sprite's rectangle.
+	// This function calls listener's OnTouchMove of the sprites that capture
+	// the pointer. If no sprite captures the pointer, sprites that contain the
+	// touched position are notified.
 	OnTouchMove(x, y float32)
 	// OnTouchEnd is called when touch is ended (released).
-	// This function calls listener's OnTouchEnd if the touched position is
-	// contained by sprite's rectangle.
+	// This function calls listener's OnTouchEnd of the sprites that capture
+	// the pointer, and releases the capture. If no sprite captures the pointer,
+	// sprites that contain the touched position are notified.
 	OnTouchEnd(x, y float32)
+	// OnMouseMove is called when mouse cursor is moved.
+	// This function calls sprite's OnPointerEnter or OnPointerLeave if the
+	// cursor enters into or leaves from sprite's rectangle.
+	OnMouseMove(x, y float32)
+	// OnMouseDown is called when a button of mouse is pressed.
+	OnMouseDown(x, y float32, button MouseButton)
+	// OnMouseUp is called when a button of mouse is released.
+	OnMouseUp(x, y float32, button MouseButton)
+	// OnMouseWheel is called when mouse wheel is scrolled.
+	OnMouseWheel(x, y, dx, dy float32)
 }
 
 type spriteNodePair struct {
-	sprite *Sprite
-	znode  *ZNode
-	inuse  bool
+	sprite  *Sprite
+	znode   *ZNode
+	inuse   bool
+	hovered bool
+	order   int
+	// texture is the texture replaced but not applied to the node yet
+	texture *Texture
 }
 
 // SpriteContainer represents array of SpriteNodePair.
 type SpriteContainer struct {
-	spriteNodePairs sync.Map // map[*Sprite]*spriteNodePair
-	gl              GLer
+	// mu guards spriteNodePairs, index, free and texture of pairs,
+	// since textures are replaced by animation goroutines
+	mu sync.RWMutex
+	// spriteNodePairs are pairs in added order to iterate them every frame
+	spriteNodePairs []*spriteNodePair
+	// index maps sprite to its pair
+	index map[*Sprite]*spriteNodePair
+	// free are pairs not in use, whose nodes are recycled for new sprites
+	free []*spriteNodePair
+	gl   GLer
+	// sequence is incremented every AddSprite to remember the order of sprites
+	sequence int
+	// captured is sprites that capture current touch pointer
+	captured []*spriteNodePair
+	// capturing is true while touch pointer is captured
+	capturing bool
 }
 
 // GetSpriteContainer returns SpriteContainer.
@@ -71,79 +107,147 @@ func (sc *SpriteContainer) Initialize(gl GLer) {
 	simlog.FuncIn()
 	sc.gl = gl
 	GetTouchPeer().AddTouchListener(sc)
+	GetMousePeer().AddMouseListener(sc)
 	simlog.FuncOut()
 }
 
 // AddSprite adds a sprite to SpriteContainer.
+// Since nodes can't be unregistered, the node of a removed sprite is
+// recycled for the sprite if available.
 func (sc *SpriteContainer) AddSprite(s *Sprite, subTex *sprite.SubTex, arrangeCallback func()) error {
 	simlog.FuncIn()
-	var sn *spriteNodePair
-	i, ok := sc.spriteNodePairs.Load(s)
-	if !ok {
-		sn = &spriteNodePair{}
-	} else {
-		sn = i.(*spriteNodePair)
-		if sn.inuse {
-			return fmt.Errorf("this sprite is already added and currently still being available")
-		}
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sn, ok := sc.index[s]
+	if ok && sn.inuse {
+		return fmt.Errorf("this sprite is already added and currently still being available")
 	}
 
-	sn.sprite = s
-	if sn.znode == nil {
-		sn.znode = sc.gl.NewNode(func(eng sprite.Engine, n *sprite.Node, t clock.Time) {
-			if arrangeCallback != nil {
-				arrangeCallback()
+	if ok {
+		// the sprite takes its own node back
+		sc.unfree(sn)
+	} else if n := len(sc.free); n > 0 {
+		sn = sc.free[n-1]
+		sc.free = sc.free[:n-1]
+		delete(sc.index, sn.sprite)
+		sc.recycle(sn, s)
+		if subTex == nil {
+			// texture of the previous sprite must not be shown
+			subTex = &sprite.SubTex{}
+		}
+	} else {
+		sn = &spriteNodePair{sprite: s}
+		var zn *ZNode
+		zn = sc.gl.NewNode(func(eng sprite.Engine, n *sprite.Node, t clock.Time) {
+			if zn.arrange != nil {
+				zn.arrange()
 			}
 		})
-		sc.spriteNodePairs.Store(s, sn)
+		zn.sprite = s
+		sn.znode = zn
+		sc.spriteNodePairs = append(sc.spriteNodePairs, sn)
+	}
+	if sc.index == nil {
+		sc.index = make(map[*Sprite]*spriteNodePair)
 	}
+	sc.index[s] = sn
+
+	sn.znode.arrange = arrangeCallback
 	sc.gl.AppendNode(sn.znode)
 	sn.inuse = true
+	sc.sequence++
+	sn.order = sc.sequence
 	if subTex != nil {
 		sc.gl.SetSubTex(sn.znode, subTex)
+		sn.texture = nil
 	}
 	simlog.FuncOut()
 	return nil
 }
 
+// recycle makes the pair and its node draw specified sprite
+func (sc *SpriteContainer) recycle(sn *spriteNodePair, s *Sprite) {
+	sn.sprite = s
+	zn := sn.znode
+	zn.sprite = s
+	zn.ZIndex = 0
+	zn.transform.invalidate()
+	zn.state.invalidate()
+	zn.culled = false
+	zn.trim = nil
+	zn.rotated = false
+	sn.texture = nil
+}
+
+// unfree removes specified pair from the pairs that can be recycled
+func (sc *SpriteContainer) unfree(remove *spriteNodePair) {
+	for i, sn := range sc.free {
+		if sn == remove {
+			sc.free = append(sc.free[:i], sc.free[i+1:]...)
+			return
+		}
+	}
+}
+
 // RemoveSprite removes a specified sprite from SpriteContainer.
 // Since Unregister of Node is not implemented by gomobile, this function just
 // marks the specified sprite as "not in use".
-// The sprite marked as "not in use" will be reused at AddSprite.
+// The node of the sprite marked as "not in use" will be recycled at AddSprite.
 func (sc *SpriteContainer) RemoveSprite(remove *Sprite) {
 	simlog.FuncIn()
-	i, ok := sc.spriteNodePairs.Load(remove)
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sn, ok := sc.index[remove]
 	if !ok {
 		return
 	}
-	sn := i.(*spriteNodePair)
 	if !sn.inuse {
 		simlog.Debug("already removed.")
 		return
 	}
 	sn.inuse = false
+	sn.hovered = false
+	sc.releaseCapture(sn)
 	sc.gl.RemoveNode(sn.znode)
+	sc.free = append(sc.free, sn)
 	simlog.FuncOut()
 }
 
 // RemoveSprites removes all registered sprites from SpriteContainer.
 func (sc *SpriteContainer) RemoveSprites() {
 	simlog.FuncIn()
-	sc.spriteNodePairs = sync.Map{}
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.spriteNodePairs = nil
+	sc.index = nil
+	sc.free = nil
+	sc.captured = nil
+	sc.capturing = false
 	simlog.FuncOut()
 }
 
+func (sc *SpriteContainer) releaseCapture(remove *spriteNodePair) {
+	var captured []*spriteNodePair
+	for _, sn := range sc.captured {
+		if sn != remove {
+			captured = append(captured, sn)
+		}
+	}
+	sc.captured = captured
+}
+
 // SetZIndex sets specified zindex to specified Sprite
 func (sc *SpriteContainer) SetZIndex(s *Sprite, z int) error {
 	simlog.FuncIn()
-	i, ok := sc.spriteNodePairs.Load(s)
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+	sn, ok := sc.index[s]
 	if !ok {
 		return fmt.Errorf("specified sprite [%p] not found", s)
 	}
-	sn := i.(*spriteNodePair)
 	if sn.znode.ZIndex != z {
 		sn.znode.ZIndex = z
-		sc.gl.ZIndexDirty()
+		sc.gl.ZIndexDirty(sn.znode)
 	}
 	return nil
 }
@@ -151,41 +255,72 @@ func (sc *SpriteContainer) SetZIndex(s *Sprite, z int) error {
 // GetZIndex returns specified sprite's zindex
 func (sc *SpriteContainer) GetZIndex(s *Sprite) (int, error) {
 	simlog.FuncIn()
-	i, ok := sc.spriteNodePairs.Load(s)
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+	sn, ok := sc.index[s]
 	if !ok {
 		return 0, fmt.Errorf("specified sprite [%p] not found", s)
 	}
-	sn := i.(*spriteNodePair)
 	return sn.znode.ZIndex, nil
 }
 
-// GetSpriteNodePairs returns map representation of sprite and node pair
-func (sc *SpriteContainer) GetSpriteNodePairs() *sync.Map {
-	return &sc.spriteNodePairs
+// GetSpriteNodePairs returns sprite and node pairs in added order,
+// including pairs that are not in use.
+// Textures replaced since the last call are applied to the nodes.
+func (sc *SpriteContainer) GetSpriteNodePairs() []*spriteNodePair {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	for _, sn := range sc.spriteNodePairs {
+		if sn.texture == nil {
+			continue
+		}
+		sc.gl.SetSubTex(sn.znode, &sn.texture.subTex)
+		sn.znode.trim = sn.texture.trim()
+		sn.znode.rotated = sn.texture.rotated
+		sn.znode.transform.invalidate()
+		sn.texture = nil
+	}
+	return sc.snapshot()
+}
+
+// snapshot returns a copy of the pairs that can be iterated without lock.
+// sc.mu must be held.
+func (sc *SpriteContainer) snapshot() []*spriteNodePair {
+	return append([]*spriteNodePair(nil), sc.spriteNodePairs...)
 }
 
 // ReplaceTexture replaces sprite's texture to specified one.
+// It is safe to call from any goroutine. The texture is applied to the
+// node at the next frame.
 func (sc *SpriteContainer) ReplaceTexture(sprite *Sprite, texture *Texture) {
 	simlog.FuncIn()
-	if i, ok := sc.spriteNodePairs.Load(sprite); ok {
-		node := i.(*spriteNodePair).znode
-		sc.gl.SetSubTex(node, &texture.subTex)
+	sc.mu.Lock()
+	if sn, ok := sc.index[sprite]; ok {
+		sn.texture = texture
 	}
+	sc.mu.Unlock()
 	simlog.FuncOut()
 }
 
 func isContained(sprite *Sprite, x, y float32) bool {
 	simlog.FuncIn()
-	if x >= sprite.X-sprite.W/2 &&
-		x <= sprite.X+sprite.W/2 &&
-		y >= sprite.Y-sprite.H/2 &&
-		y <= sprite.Y+sprite.H/2 {
-		simlog.Debug("true")
-		return true
-	}
-	simlog.Debug("false")
-	simlog.FuncOut()
-	return false
+	defer simlog.FuncOut()
+	if !sprite.IsVisible() {
+		// hidden sprite is never hit
+		return false
+	}
+	if sprite.isClipped(x, y) {
+		return false
+	}
+	lx, ly, ok := toLocal(sprite, x, y)
+	if !ok {
+		return false
+	}
+	shape := sprite.hitShape
+	if shape == nil {
+		shape = RectShape{}
+	}
+	return shape.Contains(lx, ly, sprite.W, sprite.H)
 }
 
 type event int
@@ -196,47 +331,201 @@ const (
 	touchEnd
 )
 
+// TouchConsumer is an optional interface for TouchListener registered to sprite.
+// If a listener implements this interface, ConsumeTouch is called right after
+// the listener is notified a touch event. Returning true stops propagation of
+// the event to the sprites behind.
+type TouchConsumer interface {
+	ConsumeTouch() bool
+}
+
+// pairKey is keys of a pair to sort pairs from front to back
+type pairKey struct {
+	sn    *spriteNodePair
+	layer int
+	z     int
+	y     float32
+}
+
+// sortedSpriteNodePairs returns sprites in use, sorted from front to back.
+// Sprite in the layer that has greater order is in front.
+// In the same layer, sprites are sorted by the layer's sort mode.
+// Sprite that has lesser zindex or y is in front. If they are the same,
+// sprite added later is in front since it is drawn latter.
+func (sc *SpriteContainer) sortedSpriteNodePairs() []*spriteNodePair {
+	var sns []*spriteNodePair
+	sc.mu.RLock()
+	pairs := sc.snapshot()
+	sc.mu.RUnlock()
+	for _, sn := range pairs {
+		if sn.inuse {
+			sns = append(sns, sn)
+		}
+	}
+	// keys are computed once, not in every comparison
+	list := sc.gl.GetLayers()
+	lo := newLayerOrder(list)
+	keys := make([]pairKey, len(sns))
+	for i, sn := range sns {
+		k := pairKey{sn: sn, layer: lo.index(sn.sprite.layer)}
+		switch list[k.layer].sortMode {
+		case SortByZIndex:
+			k.z = sn.znode.ZIndex
+		case SortByY:
+			k.y = sn.znode.y()
+		}
+		keys[i] = k
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		ki, kj := &keys[i], &keys[j]
+		if ki.layer != kj.layer {
+			return ki.layer > kj.layer
+		}
+		if ki.z != kj.z {
+			return ki.z < kj.z
+		}
+		if ki.y != kj.y {
+			return ki.y < kj.y
+		}
+		return ki.sn.order > kj.sn.order
+	})
+	for i := range keys {
+		sns[i] = keys[i].sn
+	}
+	return sns
+}
+
+// notifyTouchEvent notifies specified event to all listeners of the sprite.
+// This returns true if one of listeners consumes the event.
+func notifyTouchEvent(s *Sprite, x, y float32, e event) bool {
+	consumed := false
+	listeners := s.touchListeners
+	for i := range listeners {
+		l := *listeners[i]
+		switch e {
+		case touchBegin:
+			l.OnTouchBegin(x, y)
+		case touchMove:
+			l.OnTouchMove(x, y)
+		case touchEnd:
+			l.OnTouchEnd(x, y)
+		default:
+			panic("unknown touch event!")
+		}
+		if c, ok := l.(TouchConsumer); ok && c.ConsumeTouch() {
+			consumed = true
+		}
+	}
+	return consumed
+}
+
 func (sc *SpriteContainer) emitTouchEvent(x, y float32, e event) {
 	simlog.FuncIn()
-	sc.spriteNodePairs.Range(func(k, v interface{}) bool {
-		s := v.(*spriteNodePair).sprite
-		listeners := s.touchListeners
-		if isContained(s, x, y) {
-			for i := range listeners {
-				switch e {
-				case touchBegin:
-					(*listeners[i]).OnTouchBegin(x, y)
-				case touchMove:
-					(*listeners[i]).OnTouchMove(x, y)
-				case touchEnd:
-					(*listeners[i]).OnTouchEnd(x, y)
-				default:
-					panic("unknown touch event!")
-				}
+	defer simlog.FuncOut()
+
+	if e != touchBegin && sc.capturing {
+		// sprites that received touch begin capture the pointer.
+		// they receive move and end even if the pointer is outside of them.
+		captured := sc.captured
+		if e == touchEnd {
+			sc.captured = nil
+			sc.capturing = false
+		}
+		for _, sn := range captured {
+			if notifyTouchEvent(sn.sprite, x, y, e) {
+				break
 			}
 		}
-		return true
-	})
-	simlog.FuncOut()
+		return
+	}
+
+	if e == touchBegin {
+		sc.captured = nil
+		sc.capturing = false
+	}
+	for _, sn := range sc.sortedSpriteNodePairs() {
+		s := sn.sprite
+		if len(s.touchListeners) == 0 || !isContained(s, x, y) {
+			continue
+		}
+		if e == touchBegin {
+			sc.captured = append(sc.captured, sn)
+			sc.capturing = true
+		}
+		if notifyTouchEvent(s, x, y, e) {
+			break
+		}
+	}
 }
 
 // OnTouchBegin is called when screen is started to touch.
 // This function calls listener's OnTouchBegin if the touched position is
-// contained by sprite's rectangle.
+// contained by sprite's rectangle. Sprites are notified from front to back
+// until a listener consumes the event. Notified sprites capture the pointer.
 func (sc *SpriteContainer) OnTouchBegin(x, y float32) {
 	sc.emitTouchEvent(x, y, touchBegin)
 }
 
 // OnTouchMove is called when touch is moved (dragged).
-// This function calls listener's OnTouchMove if the touched position is
-// contained by sprite's rectangle.
+// This function calls listener's OnTouchMove of the sprites that capture
+// the pointer. If no sprite captures the pointer, sprites that contain the
+// touched position are notified.
 func (sc *SpriteContainer) OnTouchMove(x, y float32) {
 	sc.emitTouchEvent(x, y, touchMove)
 }
 
 // OnTouchEnd is called when touch is ended (released).
-// This function calls listener's OnTouchEnd if the touched position is
-// contained by sprite's rectangle.
+// This function calls listener's OnTouchEnd of the sprites that capture
+// the pointer, and releases the capture. If no sprite captures the pointer,
+// sprites that contain the touched position are notified.
 func (sc *SpriteContainer) OnTouchEnd(x, y float32) {
 	sc.emitTouchEvent(x, y, touchEnd)
 }
+
+// OnMouseMove is called when mouse cursor is moved.
+// This function calls sprite's OnPointerEnter or OnPointerLeave if the
+// cursor enters into or leaves from sprite's rectangle.
+func (sc *SpriteContainer) OnMouseMove(x, y float32) {
+	simlog.FuncIn()
+	// listeners may add sprites while iterating
+	sc.mu.RLock()
+	sns := sc.snapshot()
+	sc.mu.RUnlock()
+	for _, sn := range sns {
+		if !sn.inuse {
+			continue
+		}
+		contained := isContained(sn.sprite, x, y)
+		if contained == sn.hovered {
+			continue
+		}
+		sn.hovered = contained
+		listeners := sn.sprite.pointerListeners
+		for i := range listeners {
+			if contained {
+				listeners[i].OnPointerEnter(x, y)
+			} else {
+				listeners[i].OnPointerLeave(x, y)
+			}
+		}
+	}
+	simlog.FuncOut()
+}
+
+// OnMouseDown is called when a button of mouse is pressed.
+// Nothing is done for sprites.
+func (sc *SpriteContainer) OnMouseDown(x, y float32, button MouseButton) {
+	// nop
+}
+
+// OnMouseUp is called when a button of mouse is released.
+// Nothing is done for sprites.
+func (sc *SpriteContainer) OnMouseUp(x, y float32, button MouseButton) {
+	// nop
+}
+
+// OnMouseWheel is called when mouse wheel is scrolled.
+// Nothing is done for sprites.
+func (sc *SpriteContainer) OnMouseWheel(x, y, dx, dy float32) {
+	// nop
+}
@@ -17,6 +17,12 @@ func TestGetSpriteContainer(t *testing.T) {
 
 type mockGLer struct {
 	GLer
+	// layers has layers of the container
+	layers GLPeer
+}
+
+func (m *mockGLer) GetLayers() []*Layer {
+	return m.layers.GetLayers()
 }
 
 func (m *mockGLer) NewNode(fn arrangerFunc) *ZNode {
@@ -35,19 +41,10 @@ func (m *mockGLer) RemoveNode(n *ZNode) {
 	// nop
 }
 
-func (m *mockGLer) ZIndexDirty() {
+func (m *mockGLer) ZIndexDirty(n *ZNode) {
 	// nop
 }
 
-func mapLen(m *sync.Map) int {
-	count := 0
-	m.Range(func(k, v interface{}) bool {
-		count++
-		return true
-	})
-	return count
-}
-
 func TestAddAndRemoveSprite(t *testing.T) {
 	sc := &SpriteContainer{}
 	sc.gl = &mockGLer{}
@@ -57,8 +54,8 @@ func TestAddAndRemoveSprite(t *testing.T) {
 	_ = sc.AddSprite(s1, nil, nil)
 	_ = sc.AddSprite(s2, nil, nil)
 	m := sc.GetSpriteNodePairs()
-	if mapLen(m) != 2 {
-		t.Errorf("unexpected result. [got] %d [want] %d", mapLen(m), 2)
+	if len(m) != 2 {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(m), 2)
 	}
 
 	// RemoveSprite marks sprites as "not in use",
@@ -66,8 +63,8 @@ func TestAddAndRemoveSprite(t *testing.T) {
 	sc.RemoveSprite(s1)
 	sc.RemoveSprite(s2)
 	m = sc.GetSpriteNodePairs()
-	if mapLen(m) != 2 {
-		t.Fatalf("unexpected result. [got] %d [want] %d", mapLen(m), 0)
+	if len(m) != 2 {
+		t.Fatalf("unexpected result. [got] %d [want] %d", len(m), 0)
 	}
 
 	// if there're "not in use" sprite in spriteContainer,
@@ -76,8 +73,8 @@ func TestAddAndRemoveSprite(t *testing.T) {
 	_ = sc.AddSprite(s1, nil, nil)
 	_ = sc.AddSprite(s2, nil, nil)
 	m = sc.GetSpriteNodePairs()
-	if mapLen(m) != 2 {
-		t.Fatalf("unexpected result. [got] %d [want] %d", mapLen(m), 2)
+	if len(m) != 2 {
+		t.Fatalf("unexpected result. [got] %d [want] %d", len(m), 2)
 	}
 
 	// if there's not "not in use" sprite in spriteContainer,
@@ -85,8 +82,8 @@ func TestAddAndRemoveSprite(t *testing.T) {
 	s3 := &Sprite{}
 	_ = sc.AddSprite(s3, nil, nil)
 	m = sc.GetSpriteNodePairs()
-	if mapLen(m) != 3 {
-		t.Fatalf("unexpected result. [got] %d [want] %d", mapLen(m), 3)
+	if len(m) != 3 {
+		t.Fatalf("unexpected result. [got] %d [want] %d", len(m), 3)
 	}
 }
 
@@ -100,8 +97,8 @@ func TestAddSpriteDuplicate(t *testing.T) {
 		t.Fatalf("failed add Sprite. err: %s", err.Error())
 	}
 	m := sc.GetSpriteNodePairs()
-	if mapLen(m) != 1 {
-		t.Errorf("unexpected result. [got] %d [want] %d", mapLen(m), 0)
+	if len(m) != 1 {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(m), 0)
 	}
 
 	// if specified sprite is already added, it will be ignored.
@@ -110,8 +107,8 @@ func TestAddSpriteDuplicate(t *testing.T) {
 		t.Fatalf("unexpected behaviour. AddSprite should return error for duplicated adding")
 	}
 	m = sc.GetSpriteNodePairs()
-	if mapLen(m) != 1 {
-		t.Errorf("unexpected result. [got] %d [want] %d", mapLen(m), 0)
+	if len(m) != 1 {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(m), 0)
 	}
 }
 
@@ -125,21 +122,21 @@ func TestRemoveSpriteDuplicate(t *testing.T) {
 		t.Fatalf("failed add Sprite. err: %s", err.Error())
 	}
 	m := sc.GetSpriteNodePairs()
-	if mapLen(m) != 1 {
-		t.Errorf("unexpected result. [got] %d [want] %d", mapLen(m), 0)
+	if len(m) != 1 {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(m), 0)
 	}
 
 	sc.RemoveSprite(s1)
 	m = sc.GetSpriteNodePairs()
-	if mapLen(m) != 1 {
-		t.Errorf("unexpected result. [got] %d [want] %d", mapLen(m), 0)
+	if len(m) != 1 {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(m), 0)
 	}
 
 	// if specified sprite is already removed, it will be ignored.
 	sc.RemoveSprite(s1)
 	m = sc.GetSpriteNodePairs()
-	if mapLen(m) != 1 {
-		t.Errorf("unexpected result. [got] %d [want] %d", mapLen(m), 0)
+	if len(m) != 1 {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(m), 0)
 	}
 }
 
@@ -153,12 +150,12 @@ func TestRemoveSprites(t *testing.T) {
 			t.Fatalf(err.Error())
 		}
 	}
-	if mapLen(&sc.spriteNodePairs) != 10 {
+	if len(sc.spriteNodePairs) != 10 {
 		t.Fatalf("unexpected map length")
 	}
 
 	sc.RemoveSprites()
-	if mapLen(&sc.spriteNodePairs) != 0 {
+	if len(sc.spriteNodePairs) != 0 {
 		t.Fatalf("unexpected map length")
 	}
 }
@@ -174,6 +171,52 @@ func TestReplaceTexture(t *testing.T) {
 	}
 	tex := &Texture{}
 	sc.ReplaceTexture(s, tex)
+	if sn := sc.index[s]; sn.texture != tex {
+		t.Errorf("unexpected result. [got] %v [want] %v", sn.texture, tex)
+	}
+	sc.GetSpriteNodePairs()
+	if sn := sc.index[s]; sn.texture != nil {
+		t.Errorf("replaced texture should be applied. [got] %v", sn.texture)
+	}
+}
+
+func TestReplaceTextureConcurrently(t *testing.T) {
+	sc := &SpriteContainer{}
+	sc.gl = &mockGLer{}
+
+	animated := &Sprite{}
+	if err := sc.AddSprite(animated, nil, nil); err != nil {
+		t.Fatalf(err.Error())
+	}
+	// textures are replaced by animation goroutines while sprites are
+	// added and removed. run with -race to detect data races.
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tex := &Texture{}
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					sc.ReplaceTexture(animated, tex)
+				}
+			}
+		}()
+	}
+	for i := 0; i < 1000; i++ {
+		s := &Sprite{}
+		if err := sc.AddSprite(s, nil, nil); err != nil {
+			t.Fatalf(err.Error())
+		}
+		sc.GetSpriteNodePairs()
+		sc.RemoveSprite(s)
+	}
+	close(done)
+	wg.Wait()
 }
 
 func TestSetZIndex(t *testing.T) {
@@ -198,13 +241,11 @@ func TestSetZIndex(t *testing.T) {
 		}
 	}
 
-	snpairs := sc.GetSpriteNodePairs()
 	for _, tc := range tcs {
-		i, ok := snpairs.Load(tc.s)
+		sn, ok := sc.index[tc.s]
 		if !ok {
 			t.Fatalf("failed to load spritenodepair")
 		}
-		sn := i.(*spriteNodePair)
 		if sn.znode.ZIndex != tc.z {
 			t.Errorf("unexpected result. [want] %d [got] %d", tc.z, sn.znode.ZIndex)
 		}
@@ -277,3 +318,211 @@ func BenchmarkAddSprite(b *testing.B) {
 		}
 	}
 }
+
+type pointerListener struct {
+	enter int
+	leave int
+}
+
+func (l *pointerListener) OnPointerEnter(x, y float32) {
+	l.enter++
+}
+
+func (l *pointerListener) OnPointerLeave(x, y float32) {
+	l.leave++
+}
+
+func TestPointerEvent(t *testing.T) {
+	sc := &SpriteContainer{}
+	sc.gl = &mockGLer{}
+
+	s := &Sprite{X: 50, Y: 50, W: 20, H: 20}
+	err := sc.AddSprite(s, nil, nil)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	l := &pointerListener{}
+	s.AddPointerListener(l)
+
+	tcs := []struct {
+		x, y  float32
+		enter int
+		leave int
+	}{
+		{x: 0, y: 0, enter: 0, leave: 0},
+		{x: 50, y: 50, enter: 1, leave: 0},
+		// moving inside of sprite doesn't fire enter event again
+		{x: 55, y: 45, enter: 1, leave: 0},
+		{x: 100, y: 100, enter: 1, leave: 1},
+		{x: 200, y: 200, enter: 1, leave: 1},
+		{x: 41, y: 59, enter: 2, leave: 1},
+	}
+	for i, tc := range tcs {
+		sc.OnMouseMove(tc.x, tc.y)
+		if l.enter != tc.enter || l.leave != tc.leave {
+			t.Errorf("[%d] unexpected result. [got] enter=%d leave=%d [want] enter=%d leave=%d",
+				i, l.enter, l.leave, tc.enter, tc.leave)
+		}
+	}
+
+	// removed sprite doesn't receive hover event
+	sc.RemoveSprite(s)
+	sc.OnMouseMove(50, 50)
+	if l.enter != 2 || l.leave != 1 {
+		t.Errorf("unexpected result. [got] enter=%d leave=%d [want] enter=%d leave=%d",
+			l.enter, l.leave, 2, 1)
+	}
+}
+
+type consumingListener struct {
+	listener
+	consume bool
+}
+
+func (l *consumingListener) ConsumeTouch() bool {
+	return l.consume
+}
+
+func TestTouchEventOrder(t *testing.T) {
+	sc := &SpriteContainer{}
+	sc.gl = &mockGLer{}
+
+	var got []string
+	newListener := func(name string, consume bool) *consumingListener {
+		record := func(x, y float32) {
+			got = append(got, name)
+		}
+		return &consumingListener{
+			listener: listener{touchBegin: record, touchMove: record, touchEnd: record},
+			consume:  consume,
+		}
+	}
+
+	back := &Sprite{X: 50, Y: 50, W: 100, H: 100}
+	middle := &Sprite{X: 50, Y: 50, W: 100, H: 100}
+	front := &Sprite{X: 50, Y: 50, W: 100, H: 100}
+	for _, s := range []*Sprite{back, middle, front} {
+		err := sc.AddSprite(s, nil, nil)
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+	}
+	_ = sc.SetZIndex(back, 2)
+	// middle and front have the same zindex. front is added later.
+	backListener := newListener("back", false)
+	middleListener := newListener("middle", false)
+	frontListener := newListener("front", false)
+	back.AddTouchListener(backListener)
+	middle.AddTouchListener(middleListener)
+	front.AddTouchListener(frontListener)
+
+	equals := func(a, b []string) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	// notified from front to back
+	sc.OnTouchBegin(50, 50)
+	sc.OnTouchEnd(50, 50)
+	want := []string{"front", "middle", "back", "front", "middle", "back"}
+	if !equals(got, want) {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+
+	// consumed event is not propagated to sprites behind
+	got = nil
+	middleListener.consume = true
+	sc.OnTouchBegin(50, 50)
+	sc.OnTouchEnd(50, 50)
+	want = []string{"front", "middle", "front", "middle"}
+	if !equals(got, want) {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+
+	// sprites that received touch begin capture the pointer
+	got = nil
+	frontListener.consume = true
+	sc.OnTouchBegin(50, 50)
+	sc.OnTouchMove(500, 500)
+	sc.OnTouchEnd(500, 500)
+	want = []string{"front", "front", "front"}
+	if !equals(got, want) {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+
+	// capture is released at touch end
+	got = nil
+	sc.OnTouchMove(500, 500)
+	if len(got) != 0 {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, []string{})
+	}
+
+	// removed sprite doesn't receive events any more
+	got = nil
+	sc.OnTouchBegin(50, 50)
+	sc.RemoveSprite(front)
+	sc.OnTouchEnd(50, 50)
+	want = []string{"front"}
+	if !equals(got, want) {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+}
+
+func TestRecycleNode(t *testing.T) {
+	sc := &SpriteContainer{}
+	sc.gl = &mockGLer{}
+
+	s1 := &Sprite{}
+	_ = sc.AddSprite(s1, nil, nil)
+	zn := sc.index[s1].znode
+	_ = sc.SetZIndex(s1, 3)
+	sc.RemoveSprite(s1)
+
+	// node of removed sprite is recycled for new sprite
+	called := false
+	s2 := &Sprite{}
+	_ = sc.AddSprite(s2, nil, func() { called = true })
+	if len(sc.spriteNodePairs) != 1 {
+		t.Fatalf("unexpected result. [got] %d [want] %d", len(sc.spriteNodePairs), 1)
+	}
+	sn, ok := sc.index[s2]
+	if !ok || sn.znode != zn || zn.sprite != s2 || zn.ZIndex != 0 {
+		t.Errorf("node should be recycled for the new sprite")
+	}
+	if _, ok := sc.index[s1]; ok {
+		t.Errorf("removed sprite should lose its node")
+	}
+	zn.arrange()
+	if !called {
+		t.Errorf("arrange callback of the new sprite should be called")
+	}
+
+	// removed sprite gets a new node since its node is in use
+	_ = sc.AddSprite(s1, nil, nil)
+	if len(sc.spriteNodePairs) != 2 || sc.index[s1].znode == zn {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(sc.spriteNodePairs), 2)
+	}
+}
+
+func BenchmarkAddAndRemoveSprite(b *testing.B) {
+	sc := &SpriteContainer{}
+	sc.gl = &mockGLer{}
+	for i := 0; i < b.N; i++ {
+		s := &Sprite{}
+		err := sc.AddSprite(s, nil, nil)
+		if err != nil {
+			b.Fatalf(err.Error())
+		}
+		sc.RemoveSprite(s)
+	}
+	if len(sc.spriteNodePairs) != 1 {
+		b.Errorf("nodes should be recycled. [got] %d", len(sc.spriteNodePairs))
+	}
+}
@@ -0,0 +1,136 @@
+package peer
+
+import (
+	"sort"
+
+	"golang.org/x/mobile/exp/sprite"
+)
+
+// TextureStats represents statistics of textures loaded from assets
+type TextureStats struct {
+	// Textures is the number of textures shared in the cache
+	Textures int
+	// References is the number of Textures that refer the shared textures
+	References int
+	// Bytes is the size of pixels of the shared textures in bytes
+	Bytes int
+	// Hits is the number of loads that reused a shared texture
+	Hits int
+	// Misses is the number of loads that decoded an asset
+	Misses int
+}
+
+// textureEntry is a texture loaded from an asset and shared by Textures
+type textureEntry struct {
+	name string
+	tex  sprite.Texture
+	// refs is the number of Textures that refer the entry
+	refs int
+	// bytes is the size of pixels of the texture
+	bytes int
+}
+
+// textureCache shares textures loaded from assets by asset name.
+// A texture is released when the last Texture that refers it is released.
+type textureCache struct {
+	entries map[string]*textureEntry
+	hits    int
+	misses  int
+}
+
+// load returns the entry of specified asset, calling load to load it if the
+// asset is not cached yet. Reference count of the entry is incremented.
+// nil is returned if load failed.
+func (c *textureCache) load(name string, load func() (sprite.Texture, error)) (*textureEntry, error) {
+	if e, ok := c.entries[name]; ok {
+		c.hits++
+		e.refs++
+		return e, nil
+	}
+	c.misses++
+	t, err := load()
+	if err != nil {
+		return nil, err
+	}
+	e := &textureEntry{
+		name: name,
+		tex:  t,
+		refs: 1,
+	}
+	if t, ok := t.(*texture); ok {
+		e.bytes = t.width * t.height * 4
+	}
+	if c.entries == nil {
+		c.entries = make(map[string]*textureEntry)
+	}
+	c.entries[name] = e
+	return e, nil
+}
+
+// release decrements reference count of the entry, and releases its texture
+// if the entry is no longer referred
+func (c *textureCache) release(e *textureEntry) {
+	e.refs--
+	if e.refs > 0 {
+		return
+	}
+	e.tex.Release()
+	// entry may be dropped from the cache by clear already
+	if c.entries[e.name] == e {
+		delete(c.entries, e.name)
+	}
+}
+
+// clear forgets all entries. Their textures must be released by the engine.
+func (c *textureCache) clear() {
+	c.entries = nil
+}
+
+// stats returns statistics of the cache
+func (c *textureCache) stats() TextureStats {
+	s := TextureStats{
+		Textures: len(c.entries),
+		Hits:     c.hits,
+		Misses:   c.misses,
+	}
+	for _, e := range c.entries {
+		s.References += e.refs
+		s.Bytes += e.bytes
+	}
+	return s
+}
+
+// GetTextureStats returns statistics of textures loaded from assets
+func (glpeer *GLPeer) GetTextureStats() TextureStats {
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+	return glpeer.textures.stats()
+}
+
+// TextureInfo represents a texture held by GL
+type TextureInfo struct {
+	// Source describes where pixels of the texture came from,
+	// such as "asset:name.png" or "text:Hello"
+	Source string
+	// Width and Height are size of the texture in pixels
+	Width, Height int
+}
+
+// GetLiveTextures returns textures held by GL, sorted by their sources.
+// Textures are restored from their sources when GL context is lost.
+func (glpeer *GLPeer) GetLiveTextures() []TextureInfo {
+	glpeer.mu.Lock()
+	defer glpeer.mu.Unlock()
+	if glpeer.eng == nil {
+		return nil
+	}
+	infos := make([]TextureInfo, 0, len(glpeer.eng.textures))
+	for t := range glpeer.eng.textures {
+		w, h := t.Bounds()
+		infos = append(infos, TextureInfo{Source: t.source, Width: w, Height: h})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Source < infos[j].Source
+	})
+	return infos
+}
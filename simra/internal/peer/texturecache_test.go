@@ -0,0 +1,83 @@
+package peer
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/mobile/exp/sprite"
+	"golang.org/x/mobile/gl"
+)
+
+// mockTextureGLContext is a gl.Context that counts deleted textures
+type mockTextureGLContext struct {
+	gl.Context
+	deleted int
+}
+
+func (m *mockTextureGLContext) DeleteTexture(v gl.Texture) { m.deleted++ }
+
+func TestTextureCache(t *testing.T) {
+	glctx := &mockTextureGLContext{}
+	e := &engine{glctx: glctx, textures: make(map[*texture]struct{})}
+	loads := 0
+	load := func() (sprite.Texture, error) {
+		loads++
+		t := &texture{e: e, gltex: gl.Texture{Value: 1}, width: 16, height: 8}
+		e.textures[t] = struct{}{}
+		return t, nil
+	}
+	glpeer := &GLPeer{eng: e}
+
+	var textures []*Texture
+	for i := 0; i < 3; i++ {
+		entry, err := glpeer.textures.load("a.png", load)
+		if err != nil {
+			t.Fatal(err)
+		}
+		textures = append(textures, &Texture{subTex: sprite.SubTex{T: entry.tex}, entry: entry})
+	}
+	if loads != 1 {
+		t.Errorf("asset should be loaded once. [got] %d [want] %d", loads, 1)
+	}
+	want := TextureStats{Textures: 1, References: 3, Bytes: 16 * 8 * 4, Hits: 2, Misses: 1}
+	if got := glpeer.GetTextureStats(); got != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+
+	glpeer.ReleaseTexture(textures[0])
+	// releasing twice does not affect other textures
+	glpeer.ReleaseTexture(textures[0])
+	glpeer.ReleaseTexture(textures[1])
+	if glctx.deleted != 0 {
+		t.Errorf("texture in use should not be deleted. [got] %d [want] %d", glctx.deleted, 0)
+	}
+	glpeer.ReleaseTexture(textures[2])
+	if glctx.deleted != 1 {
+		t.Errorf("unexpected result. [got] %d [want] %d", glctx.deleted, 1)
+	}
+	want = TextureStats{Hits: 2, Misses: 1}
+	if got := glpeer.GetTextureStats(); got != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+
+	// released asset is loaded again
+	if _, err := glpeer.textures.load("a.png", load); err != nil {
+		t.Fatal(err)
+	}
+	if loads != 2 {
+		t.Errorf("unexpected result. [got] %d [want] %d", loads, 2)
+	}
+
+	// failed load is not cached
+	fail := func() (sprite.Texture, error) {
+		return nil, errors.New("not found")
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := glpeer.textures.load("b.png", fail); err == nil {
+			t.Errorf("error should be returned")
+		}
+	}
+	if got := glpeer.GetTextureStats().Textures; got != 1 {
+		t.Errorf("unexpected result. [got] %d [want] %d", got, 1)
+	}
+}
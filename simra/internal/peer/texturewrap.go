@@ -0,0 +1,109 @@
+package peer
+
+import (
+	"image"
+	"math"
+
+	"golang.org/x/mobile/exp/f32"
+)
+
+// maxWrapScale is the maximum number of repetitions across sprite along an axis
+const maxWrapScale = 64
+
+// TextureWrap represents repetition of texture over sprite.
+// Texture coordinates of sprite, that are (0, 0) at top left and (1, 1) at
+// bottom right, are multiplied by scale and shifted by offset, and the
+// texture is repeated out of 0..1.
+type TextureWrap struct {
+	// ScaleU and ScaleV are the number of repetitions across sprite.
+	// zero is regarded as 1. They are clamped to 64.
+	ScaleU, ScaleV float32
+	// OffsetU and OffsetV shift the texture by its size.
+	// Increasing OffsetU scrolls the texture to the left.
+	OffsetU, OffsetV float32
+}
+
+// wrapSpan is a range of a repetition along an axis
+type wrapSpan struct {
+	// t0 and t1 are range in the texture in texels, that may be fractional
+	t0, t1 float32
+	// s0 and s1 are range in the unit square of sprite
+	s0, s1 float32
+}
+
+// wrapAxis appends spans of texture from min to max repeated from offset
+// for scale times to dst.
+// Partial repetitions at edges of sprite are cut at fractional texels.
+func wrapAxis(dst []wrapSpan, min, max int, offset, scale float32) []wrapSpan {
+	n := float64(max - min)
+	s := math.Abs(float64(scale))
+	if s == 0 {
+		s = 1
+	}
+	if n == 0 || math.IsNaN(s) || math.IsNaN(float64(offset)) || math.IsInf(float64(offset), 0) {
+		return dst
+	}
+	s = math.Min(s, maxWrapScale)
+	// only the fraction of offset matters
+	start := float64(offset) - math.Floor(float64(offset))
+	end := start + s
+	for k := 0.0; k < end; k++ {
+		u0, u1 := math.Max(start, k), math.Min(end, k+1)
+		if u1 <= u0 {
+			continue
+		}
+		dst = append(dst, wrapSpan{
+			t0: float32(float64(min) + (u0-k)*n),
+			t1: float32(float64(min) + (u1-k)*n),
+			s0: float32((u0 - start) / s),
+			s1: float32((u1 - start) / s),
+		})
+	}
+	return dst
+}
+
+// pieces appends pieces of sub rectangle r of a texture repeated over sprite
+// to dst. xs and ys are buffers of spans reused every frame.
+func (w *TextureWrap) pieces(dst []slicePiece, xs, ys *[]wrapSpan, r image.Rectangle) []slicePiece {
+	*xs = wrapAxis((*xs)[:0], r.Min.X, r.Max.X, w.OffsetU, w.ScaleU)
+	*ys = wrapAxis((*ys)[:0], r.Min.Y, r.Max.Y, w.OffsetV, w.ScaleV)
+	dx, dy := float32(r.Dx()), float32(r.Dy())
+	for _, y := range *ys {
+		for _, x := range *xs {
+			u0, u1 := (x.t0-float32(r.Min.X))/dx, (x.t1-float32(r.Min.X))/dx
+			v0, v1 := (y.t0-float32(r.Min.Y))/dy, (y.t1-float32(r.Min.Y))/dy
+			dst = append(dst, slicePiece{
+				r: r,
+				m: f32.Affine{
+					{x.s1 - x.s0, 0, x.s0},
+					{0, y.s1 - y.s0, y.s0},
+				},
+				uv: f32.Affine{
+					{u1 - u0, 0, u0},
+					{0, v1 - v0, v0},
+				},
+			})
+		}
+	}
+	return dst
+}
+
+// SetTextureWrap repeats texture over sprite as specified.
+// Nine-sliced sprite does not repeat its texture. nil stops repetition.
+func (s *Sprite) SetTextureWrap(w *TextureWrap) {
+	if w == nil {
+		s.wrap = nil
+		return
+	}
+	c := *w
+	s.wrap = &c
+}
+
+// GetTextureWrap returns repetition of texture over sprite. nil if not repeated.
+func (s *Sprite) GetTextureWrap() *TextureWrap {
+	if s.wrap == nil {
+		return nil
+	}
+	c := *s.wrap
+	return &c
+}
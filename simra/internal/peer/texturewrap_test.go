@@ -0,0 +1,83 @@
+package peer
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestWrapAxis(t *testing.T) {
+	tcs := []struct {
+		name          string
+		offset, scale float32
+		want          []wrapSpan
+	}{
+		{name: "default", want: []wrapSpan{{0, 8, 0, 1}}},
+		{name: "repeat", scale: 2, want: []wrapSpan{{0, 8, 0, 0.5}, {0, 8, 0.5, 1}}},
+		{name: "offset", offset: 0.25, scale: 1, want: []wrapSpan{{2, 8, 0, 0.75}, {0, 2, 0.75, 1}}},
+		{name: "negative offset", offset: -1.5, scale: 1, want: []wrapSpan{{4, 8, 0, 0.5}, {0, 4, 0.5, 1}}},
+		// partial repetitions at edges are cut at fractional texels
+		{name: "subpixel", offset: 0.1, scale: 1, want: []wrapSpan{{0.8, 8, 0, 0.9}, {0, 0.8, 0.9, 1}}},
+		{name: "huge offset", offset: 1e6 + 0.5, scale: 1, want: []wrapSpan{{4, 8, 0, 0.5}, {0, 4, 0.5, 1}}},
+		{name: "infinite offset", offset: float32(math.Inf(1)), scale: 1},
+	}
+	for _, tc := range tcs {
+		got := wrapAxis(nil, 0, 8, tc.offset, tc.scale)
+		if len(got) != len(tc.want) {
+			t.Errorf("[%s] unexpected result. [got] %v [want] %v", tc.name, got, tc.want)
+			continue
+		}
+		for i := range got {
+			g, w := got[i], tc.want[i]
+			if !nearlyEqual(g.t0, w.t0) || !nearlyEqual(g.t1, w.t1) || !nearlyEqual(g.s0, w.s0) || !nearlyEqual(g.s1, w.s1) {
+				t.Errorf("[%s] unexpected result. [got] %v [want] %v", tc.name, got, tc.want)
+			}
+		}
+	}
+
+	// extreme scale is clamped
+	if got := wrapAxis(nil, 0, 8, 0, 1e9); len(got) != maxWrapScale {
+		t.Errorf("unexpected result. [got] %d [want] %d", len(got), maxWrapScale)
+	}
+}
+
+func TestRenderTextureWrap(t *testing.T) {
+	s := &Sprite{X: 2, Y: 2, W: 4, H: 4}
+
+	glpeer := &GLPeer{}
+	glpeer.znodes = ZNodes{newTargetTestNode(s)}
+	glpeer.layoutDirty = true
+
+	rt, err := glpeer.NewRenderTarget(4, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt.AddSprite(s)
+	tcs := []struct {
+		offset float32
+		want   []color.RGBA
+	}{
+		{offset: 0, want: []color.RGBA{red, blue, red, blue}},
+		{offset: 0.5, want: []color.RGBA{blue, red, blue, red}},
+	}
+	for _, tc := range tcs {
+		s.SetTextureWrap(&TextureWrap{ScaleV: 2, OffsetV: tc.offset})
+		glpeer.RenderTo(rt)
+		for y, want := range tc.want {
+			if got := rt.Image().RGBAAt(3, y); got != want {
+				t.Errorf("[%f, %d] unexpected result. [got] %v [want] %v", tc.offset, y, got, want)
+			}
+		}
+	}
+}
+
+func TestTextureWrapPiecesNoAlloc(t *testing.T) {
+	s := &Sprite{W: 4, H: 4}
+	s.SetTextureWrap(&TextureWrap{ScaleU: 3, ScaleV: 2, OffsetU: 0.3})
+	zn := newTargetTestNode(s)
+	zn.texturePieces()
+	// buffers of the previous frame are reused
+	if n := testing.AllocsPerRun(10, func() { zn.texturePieces() }); n != 0 {
+		t.Errorf("unexpected result. [got] %f [want] %d", n, 0)
+	}
+}
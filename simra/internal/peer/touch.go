@@ -12,13 +12,16 @@ type Toucher interface {
 	RemoveAllTouchListeners()
 	// OnTouchBegin is called when touch is started.
 	// This event is notified to all registered listeners despite of the touched position.
-	OnTouchBegin(pxx, pxy float32)
+	// id tells simultaneous touches apart.
+	OnTouchBegin(id int, pxx, pxy float32)
 	// OnTouchMove is called when touch is moved (dragged).
 	// This event is notified to all registered listeners despite of the touched position.
-	OnTouchMove(pxx, pxy float32)
+	// id tells simultaneous touches apart.
+	OnTouchMove(id int, pxx, pxy float32)
 	// OnTouchEnd is called when touch is ended (released).
 	// This event is notified to all registered listeners despite of the touched position.
-	OnTouchEnd(pxx, pxy float32)
+	// id tells simultaneous touches apart.
+	OnTouchEnd(id int, pxx, pxy float32)
 }
 
 // TouchPeer represents a Touch object.
@@ -37,6 +40,16 @@ type TouchListener interface {
 	OnTouchEnd(x, y float32)
 }
 
+// MultiTouchListener is an optional interface for TouchListener to tell
+// simultaneous touches apart. If a listener implements this interface,
+// its methods are called with id of the touch instead of TouchListener's.
+// id is unique among touches in progress.
+type MultiTouchListener interface {
+	OnMultiTouchBegin(id int, x, y float32)
+	OnMultiTouchMove(id int, x, y float32)
+	OnMultiTouchEnd(id int, x, y float32)
+}
+
 // GetTouchPeer returns instance of TouchPeer.
 // Since TouchPeer is singleton, it is necessary to
 // call this function to get instance of TouchPeer.
@@ -77,49 +90,50 @@ func (tp *TouchPeer) RemoveAllTouchListeners() {
 }
 
 func (tp *TouchPeer) calcTouchedPosition(pxx, pxy float32) (float32, float32) {
-	ptx := pxx / tp.screensize.sz.PixelsPerPt
-	pty := pxy / tp.screensize.sz.PixelsPerPt
-
-	var scale float32
-	if tp.screensize.fitTo == fitHeight {
-		scale = tp.screensize.height / float32(tp.screensize.sz.HeightPt)
-	} else {
-		scale = tp.screensize.width / float32(tp.screensize.sz.WidthPt)
-	}
-
-	return (ptx - tp.screensize.marginWidth/2) * scale,
-		tp.screensize.height - (pty-tp.screensize.marginHeight/2)*scale
+	return tp.screensize.calcVirtualPosition(pxx, pxy)
 }
 
 // OnTouchBegin is called when touch is started.
 // This event is notified to all registered listeners despite of the touched position.
-func (tp *TouchPeer) OnTouchBegin(pxx, pxy float32) {
+func (tp *TouchPeer) OnTouchBegin(id int, pxx, pxy float32) {
 	simlog.FuncIn()
 	x, y := tp.calcTouchedPosition(pxx, pxy)
-	for i := range tp.touchListeners {
-		tp.touchListeners[i].OnTouchBegin(x, y)
+	for _, l := range tp.touchListeners {
+		if m, ok := l.(MultiTouchListener); ok {
+			m.OnMultiTouchBegin(id, x, y)
+			continue
+		}
+		l.OnTouchBegin(x, y)
 	}
 	simlog.FuncOut()
 }
 
 // OnTouchMove is called when touch is moved (dragged).
 // This event is notified to all registered listeners despite of the touched position.
-func (tp *TouchPeer) OnTouchMove(pxx, pxy float32) {
+func (tp *TouchPeer) OnTouchMove(id int, pxx, pxy float32) {
 	simlog.FuncIn()
 	x, y := tp.calcTouchedPosition(pxx, pxy)
-	for i := range tp.touchListeners {
-		tp.touchListeners[i].OnTouchMove(x, y)
+	for _, l := range tp.touchListeners {
+		if m, ok := l.(MultiTouchListener); ok {
+			m.OnMultiTouchMove(id, x, y)
+			continue
+		}
+		l.OnTouchMove(x, y)
 	}
 	simlog.FuncOut()
 }
 
 // OnTouchEnd is called when touch is ended (released).
 // This event is notified to all registered listeners despite of the touched position.
-func (tp *TouchPeer) OnTouchEnd(pxx, pxy float32) {
+func (tp *TouchPeer) OnTouchEnd(id int, pxx, pxy float32) {
 	simlog.FuncIn()
 	x, y := tp.calcTouchedPosition(pxx, pxy)
-	for i := range tp.touchListeners {
-		tp.touchListeners[i].OnTouchEnd(x, y)
+	for _, l := range tp.touchListeners {
+		if m, ok := l.(MultiTouchListener); ok {
+			m.OnMultiTouchEnd(id, x, y)
+			continue
+		}
+		l.OnTouchEnd(x, y)
 	}
 	simlog.FuncOut()
 }
@@ -1,6 +1,9 @@
 package peer
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+)
 
 func newTestTouchPeer() *TouchPeer {
 	return &TouchPeer{
@@ -68,7 +71,38 @@ func TestTouchEvents(t *testing.T) {
 		touchEnd:   func(x, y float32) {},
 	}
 	touch.AddTouchListener(l)
-	touch.OnTouchBegin(0, 0)
-	touch.OnTouchMove(0, 0)
-	touch.OnTouchEnd(0, 0)
+	touch.OnTouchBegin(0, 0, 0)
+	touch.OnTouchMove(0, 0, 0)
+	touch.OnTouchEnd(0, 0, 0)
+}
+
+// multiTouchListener records ids of touches
+type multiTouchListener struct {
+	listener
+	ids []int
+}
+
+func (l *multiTouchListener) OnMultiTouchBegin(id int, x, y float32) {
+	l.ids = append(l.ids, id)
+}
+func (l *multiTouchListener) OnMultiTouchMove(id int, x, y float32) {
+	l.ids = append(l.ids, id)
+}
+func (l *multiTouchListener) OnMultiTouchEnd(id int, x, y float32) {
+	l.ids = append(l.ids, id)
+}
+
+func TestMultiTouchEvents(t *testing.T) {
+	touch := newTestTouchPeer()
+	l := &multiTouchListener{}
+	touch.AddTouchListener(l)
+	touch.OnTouchBegin(0, 0, 0)
+	touch.OnTouchBegin(1, 0, 0)
+	touch.OnTouchEnd(0, 0, 0)
+	touch.OnTouchMove(1, 0, 0)
+
+	want := []int{0, 1, 0, 1}
+	if fmt.Sprint(l.ids) != fmt.Sprint(want) {
+		t.Errorf("unexpected result. [got] %v [want] %v", l.ids, want)
+	}
 }
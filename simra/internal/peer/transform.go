@@ -0,0 +1,307 @@
+package peer
+
+import (
+	"math"
+
+	"golang.org/x/mobile/exp/f32"
+)
+
+// SetFlip sets whether sprite's texture is mirrored horizontally and vertically.
+// Texture is mirrored around the center of sprite.
+func (s *Sprite) SetFlip(x, y bool) {
+	s.flipX, s.flipY = x, y
+}
+
+// GetFlip returns whether sprite's texture is mirrored horizontally and vertically.
+func (s *Sprite) GetFlip() (x, y bool) {
+	return s.flipX, s.flipY
+}
+
+// SetAnchor sets anchor point of sprite in 0..1 of sprite's size.
+// (0, 0) is bottom left and (1, 1) is top right of sprite.
+// Sprite's position specifies where the anchor point is placed,
+// and sprite rotates and skews around the anchor point.
+// Default is (0.5, 0.5), that is the center of sprite.
+func (s *Sprite) SetAnchor(x, y float32) {
+	s.pivotX, s.pivotY = x-0.5, y-0.5
+}
+
+// GetAnchor returns anchor point of sprite.
+func (s *Sprite) GetAnchor() (x, y float32) {
+	return s.pivotX + 0.5, s.pivotY + 0.5
+}
+
+// SetSkew sets skew angles of sprite in radian.
+// x slants vertical edges and y slants horizontal edges.
+func (s *Sprite) SetSkew(x, y float32) {
+	s.skewX, s.skewY = x, y
+}
+
+// GetSkew returns skew angles of sprite.
+func (s *Sprite) GetSkew() (x, y float32) {
+	return s.skewX, s.skewY
+}
+
+// SetScreenSpace sets whether sprite is placed in screen space.
+// Screen-space sprite is not affected by camera, that is useful for HUD.
+// Children of screen-space sprite are also placed in screen space.
+func (s *Sprite) SetScreenSpace(screenSpace bool) {
+	s.screenSpace = screenSpace
+}
+
+// IsScreenSpace returns true if sprite or its root ancestor is placed in screen space.
+func (s *Sprite) IsScreenSpace() bool {
+	root := s
+	for root.parent != nil {
+		root = root.parent
+	}
+	return root.screenSpace
+}
+
+// SetLayerBits sets layer bits of sprite.
+// Sprite is rendered in viewports whose layer mask shares any bit with
+// the layer bits. Default is 1.
+func (s *Sprite) SetLayerBits(bits uint32) {
+	// store inverted lowest bit so that zero value means default
+	s.layerBits = bits ^ 1
+}
+
+// GetLayerBits returns layer bits of sprite.
+func (s *Sprite) GetLayerBits() uint32 {
+	return s.layerBits ^ 1
+}
+
+// nodeTransform returns an affine that maps coordinates of sprite's children,
+// whose origin is the anchor point of sprite, to coordinates of sprite's parent.
+func (s *Sprite) nodeTransform() f32.Affine {
+	// rotation * skew * scale
+	m00, m01, m10, m11 := float32(1), float32(0), float32(0), float32(1)
+	if s.skewX != 0 || s.skewY != 0 {
+		m01 = float32(math.Tan(float64(s.skewX)))
+		m10 = float32(math.Tan(float64(s.skewY)))
+	}
+	if s.R != 0 {
+		sin, cos := math.Sincos(float64(s.R))
+		sn, cs := float32(sin), float32(cos)
+		m00, m01, m10, m11 = cs*m00-sn*m10, cs*m01-sn*m11, sn*m00+cs*m10, sn*m01+cs*m11
+	}
+	sx, sy := s.GetScaleFactor()
+	return f32.Affine{
+		{m00 * sx, m01 * sy, s.X},
+		{m10 * sx, m11 * sy, s.Y},
+	}
+}
+
+// worldTransform returns an affine that maps coordinates of sprite's children
+// to world coordinates, considering transforms of all ancestors.
+func (s *Sprite) worldTransform() f32.Affine {
+	m := s.nodeTransform()
+	for p := s.parent; p != nil; p = p.parent {
+		pm := p.nodeTransform()
+		m.Mul(&pm, &m)
+	}
+	return m
+}
+
+// localTransform returns an affine that maps sprite-local coordinates,
+// whose origin is the center of sprite, to world coordinates.
+func (s *Sprite) localTransform() f32.Affine {
+	m := s.worldTransform()
+	// offset from anchor point to the center of sprite
+	m.Translate(&m, -s.pivotX*s.W, -s.pivotY*s.H)
+	return m
+}
+
+// transform returns an affine that maps unit square of texture,
+// whose origin is top left and y axis goes downward, to world coordinates.
+func (s *Sprite) transform() f32.Affine {
+	fx, fy := float32(1), float32(1)
+	if s.flipX {
+		fx = -1
+	}
+	if s.flipY {
+		fy = -1
+	}
+	tex := f32.Affine{
+		{fx * s.W, 0, -fx * s.W / 2},
+		{0, -fy * s.H, fy * s.H / 2},
+	}
+	local := s.localTransform()
+	var m f32.Affine
+	m.Mul(&local, &tex)
+	return m
+}
+
+// toLocal converts specified position in virtual screen coordinates
+// to sprite-local coordinates, considering camera, position, anchor, rotation,
+// skew and flip of sprite. The camera is the one of viewport that contains
+// the position. ok is false if the sprite is not shown at the position's viewport.
+func toLocal(s *Sprite, x, y float32) (lx, ly float32, ok bool) {
+	sc := s.getScene()
+	vp := sc.defaultViewport
+	if !s.IsScreenSpace() {
+		vp = sc.viewportAt(x, y)
+		if vp == nil || !vp.shows(s) {
+			return 0, 0, false
+		}
+	}
+	view := vp.view(s)
+	local := s.localTransform()
+	var inv f32.Affine
+	inv.Mul(&view, &local)
+	inv.Inverse(&inv)
+	lx = inv[0][0]*x + inv[0][1]*y + inv[0][2]
+	ly = inv[1][0]*x + inv[1][1]*y + inv[1][2]
+	if s.flipX {
+		lx = -lx
+	}
+	if s.flipY {
+		ly = -ly
+	}
+	return lx, ly, true
+}
+
+// transformInputs are fields of a sprite that its transform depends on
+type transformInputs struct {
+	x, y, w, h, r  float32
+	pivotX, pivotY float32
+	skewX, skewY   float32
+	scaleX, scaleY float32
+	flipX, flipY   bool
+	parent         *Sprite
+}
+
+func (s *Sprite) transformInputs() transformInputs {
+	return transformInputs{
+		x: s.X, y: s.Y, w: s.W, h: s.H, r: s.R,
+		pivotX: s.pivotX, pivotY: s.pivotY,
+		skewX: s.skewX, skewY: s.skewY,
+		scaleX: s.scaleX, scaleY: s.scaleY,
+		flipX: s.flipX, flipY: s.flipY,
+		parent: s.parent,
+	}
+}
+
+// transformCache remembers the transform last set to a node, to skip
+// recomputing it while the sprite and its ancestors don't change.
+// Transforms on the screen are kept for each viewport, so that rendering
+// in another viewport doesn't invalidate them.
+type transformCache struct {
+	// inputs are inputs of the sprite and its ancestors, from the sprite to its root
+	inputs []transformInputs
+	// local maps unit square of the node to world coordinates, if localValid
+	local      f32.Affine
+	localValid bool
+	// views are transforms on the screen by index of viewport
+	views []viewTransform
+	// applied is index of viewport + 1 whose transform is set to the node.
+	// 0 if none.
+	applied int
+}
+
+// viewTransform is a transform of a node seen through a view
+type viewTransform struct {
+	valid  bool
+	view   f32.Affine
+	affine f32.Affine
+	// bounds is bounding box of affine
+	bounds bounds
+}
+
+// sync remembers current inputs of the sprite and drops cached transforms
+// if they differ from the last ones.
+func (c *transformCache) sync(s *Sprite) {
+	dirty := false
+	n := 0
+	for p := s; p != nil; p = p.parent {
+		in := p.transformInputs()
+		if n < len(c.inputs) {
+			if c.inputs[n] != in {
+				c.inputs[n] = in
+				dirty = true
+			}
+		} else {
+			c.inputs = append(c.inputs, in)
+			dirty = true
+		}
+		n++
+	}
+	if n != len(c.inputs) {
+		c.inputs = c.inputs[:n]
+		dirty = true
+	}
+	if dirty {
+		c.invalidate()
+	}
+}
+
+// at returns cached transform of viewport i seen through view.
+// nil if it should be computed.
+func (c *transformCache) at(i int, view *f32.Affine) *viewTransform {
+	if i >= len(c.views) || !c.views[i].valid || c.views[i].view != *view {
+		return nil
+	}
+	return &c.views[i]
+}
+
+// store caches affine as transform of viewport i seen through view
+func (c *transformCache) store(i int, view, affine *f32.Affine) *viewTransform {
+	for len(c.views) <= i {
+		c.views = append(c.views, viewTransform{})
+	}
+	vt := &c.views[i]
+	*vt = viewTransform{valid: true, view: *view, affine: *affine, bounds: boundsOf(affine)}
+	if c.applied == i+1 {
+		// transform of the node is outdated
+		c.applied = 0
+	}
+	return vt
+}
+
+// invalidate drops all cached transforms
+func (c *transformCache) invalidate() {
+	c.localValid = false
+	for i := range c.views {
+		c.views[i].valid = false
+	}
+	c.applied = 0
+}
+
+// boundingCircle returns center and radius of a circle in world coordinates
+// that contains the sprite. It is cheaper than the transform of the sprite,
+// since only ancestors are transformed.
+func (s *Sprite) boundingCircle() (x, y, r float32) {
+	// farthest corner from the anchor point
+	hw := abs32(s.W)/2 + abs32(s.pivotX*s.W)
+	hh := abs32(s.H)/2 + abs32(s.pivotY*s.H)
+	r = float32(math.Hypot(float64(hw), float64(hh)))
+	sx, sy := s.GetScaleFactor()
+	if abs32(sx) > abs32(sy) {
+		r *= abs32(sx)
+	} else {
+		r *= abs32(sy)
+	}
+	if s.skewX != 0 || s.skewY != 0 {
+		r *= 1 + abs32(float32(math.Tan(float64(s.skewX)))) + abs32(float32(math.Tan(float64(s.skewY))))
+	}
+	// rotation keeps the radius
+	x, y = s.X, s.Y
+	for p := s.parent; p != nil; p = p.parent {
+		m := p.nodeTransform()
+		x, y = m[0][0]*x+m[0][1]*y+m[0][2], m[1][0]*x+m[1][1]*y+m[1][2]
+		r *= scaleBound(&m)
+	}
+	return x, y, r
+}
+
+// scaleBound returns an upper bound of how much m scales lengths
+func scaleBound(m *f32.Affine) float32 {
+	return float32(math.Sqrt(float64(m[0][0]*m[0][0] + m[0][1]*m[0][1] + m[1][0]*m[1][0] + m[1][1]*m[1][1])))
+}
+
+func abs32(v float32) float32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
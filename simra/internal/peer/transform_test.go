@@ -0,0 +1,76 @@
+package peer
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/mobile/exp/f32"
+)
+
+// transformPoint applies affine m to (x, y)
+func transformPoint(m f32.Affine, x, y float32) (float32, float32) {
+	return m[0][0]*x + m[0][1]*y + m[0][2], m[1][0]*x + m[1][1]*y + m[1][2]
+}
+
+func nearlyEqual(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 1e-4
+}
+
+func TestSpriteTransform(t *testing.T) {
+	tcs := []struct {
+		name   string
+		setup  func(s *Sprite)
+		u, v   float32
+		wx, wy float32
+	}{
+		// top left of texture goes to top left of sprite
+		{name: "default", setup: func(s *Sprite) {}, u: 0, v: 0, wx: 80, wy: 220},
+		{name: "default", setup: func(s *Sprite) {}, u: 1, v: 1, wx: 120, wy: 180},
+		{name: "flipX", setup: func(s *Sprite) { s.SetFlip(true, false) }, u: 0, v: 0, wx: 120, wy: 220},
+		{name: "flipY", setup: func(s *Sprite) { s.SetFlip(false, true) }, u: 0, v: 0, wx: 80, wy: 180},
+		// position specifies bottom left of sprite
+		{name: "anchor", setup: func(s *Sprite) { s.SetAnchor(0, 0) }, u: 0, v: 1, wx: 100, wy: 200},
+		// rotates around bottom left
+		{name: "anchor+rotate", setup: func(s *Sprite) {
+			s.SetAnchor(0, 0)
+			s.R = math.Pi / 2
+		}, u: 1, v: 1, wx: 100, wy: 240},
+		// top edge is slanted to right
+		{name: "skew", setup: func(s *Sprite) { s.SetSkew(math.Pi/4, 0) }, u: 0, v: 0, wx: 100, wy: 220},
+	}
+	for _, tc := range tcs {
+		s := &Sprite{X: 100, Y: 200, W: 40, H: 40}
+		tc.setup(s)
+		x, y := transformPoint(s.transform(), tc.u, tc.v)
+		if !nearlyEqual(x, tc.wx) || !nearlyEqual(y, tc.wy) {
+			t.Errorf("[%s] unexpected result. [got] (%f, %f) [want] (%f, %f)", tc.name, x, y, tc.wx, tc.wy)
+		}
+	}
+}
+
+func TestAnchoredSpriteContains(t *testing.T) {
+	s := &Sprite{X: 100, Y: 100, W: 40, H: 20}
+	s.SetAnchor(0, 0)
+
+	if !s.Contains(130, 110) {
+		t.Errorf("(130, 110) should hit the sprite anchored at bottom left")
+	}
+	if s.Contains(90, 95) {
+		t.Errorf("(90, 95) should not hit the sprite anchored at bottom left")
+	}
+
+	// rotated around the anchor, sprite lies on the left side of the anchor
+	s.R = math.Pi
+	if !s.Contains(70, 90) || s.Contains(130, 110) {
+		t.Errorf("unexpected result of rotated sprite")
+	}
+}
+
+func TestFlippedSpriteLocal(t *testing.T) {
+	s := &Sprite{X: 100, Y: 100, W: 40, H: 20}
+	s.SetFlip(true, false)
+	x, y, ok := toLocal(s, 110, 105)
+	if !ok || !nearlyEqual(x, -10) || !nearlyEqual(y, 5) {
+		t.Errorf("unexpected result. [got] (%f, %f) [want] (%f, %f)", x, y, -10.0, 5.0)
+	}
+}
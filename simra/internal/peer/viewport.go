@@ -0,0 +1,156 @@
+package peer
+
+import (
+	"golang.org/x/mobile/exp/f32"
+)
+
+// Viewport represents a rectangle of screen where the world is rendered
+// through its own camera.
+type Viewport struct {
+	// rectangle in virtual screen coordinates. (x, y) is bottom left.
+	x, y, w, h float32
+	camera     *Camera
+	layerMask  uint32
+}
+
+// NewViewport returns a viewport that occupies specified rectangle of
+// virtual screen. (x, y) is bottom left of the rectangle.
+// The viewport has its own camera and shows sprites of all layer bits.
+func NewViewport(x, y, w, h float32) *Viewport {
+	vp := &Viewport{
+		x:         x,
+		y:         y,
+		w:         w,
+		h:         h,
+		layerMask: ^uint32(0),
+	}
+	vp.camera = &Camera{viewport: vp}
+	return vp
+}
+
+// SetRect sets rectangle of the viewport in virtual screen coordinates.
+func (vp *Viewport) SetRect(x, y, w, h float32) {
+	vp.x, vp.y, vp.w, vp.h = x, y, w, h
+}
+
+// GetRect returns rectangle of the viewport in virtual screen coordinates.
+func (vp *Viewport) GetRect() (x, y, w, h float32) {
+	return vp.x, vp.y, vp.w, vp.h
+}
+
+// GetCamera returns camera of the viewport.
+func (vp *Viewport) GetCamera() *Camera {
+	return vp.camera
+}
+
+// SetLayerMask sets mask of layer bits to render.
+// Sprites whose layer bits share no bit with the mask are not rendered
+// in the viewport, and not hit by touches in the viewport.
+func (vp *Viewport) SetLayerMask(mask uint32) {
+	vp.layerMask = mask
+}
+
+// GetLayerMask returns mask of layer bits to render.
+func (vp *Viewport) GetLayerMask() uint32 {
+	return vp.layerMask
+}
+
+// contains returns true if specified position in virtual screen coordinates
+// is inside of the viewport.
+func (vp *Viewport) contains(x, y float32) bool {
+	return x >= vp.x && x < vp.x+vp.w && y >= vp.y && y < vp.y+vp.h
+}
+
+// shows returns true if specified sprite is rendered in the viewport.
+func (vp *Viewport) shows(s *Sprite) bool {
+	return vp.layerMask&s.GetLayerBits() != 0
+}
+
+// view returns an affine that maps coordinates of sprite's space to
+// virtual screen coordinates in the viewport.
+func (vp *Viewport) view(s *Sprite) f32.Affine {
+	if s.IsScreenSpace() {
+		return f32.Affine{
+			{1, 0, 0},
+			{0, 1, 0},
+		}
+	}
+	return vp.camera.view()
+}
+
+// AddViewport adds specified viewport to the screen.
+// While no viewport is added, whole screen is rendered through the main camera.
+// Viewports are rendered in added order, so that later one is drawn over former ones.
+func (glpeer *GLPeer) AddViewport(vp *Viewport) {
+	sc := glpeer.getScene()
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.viewports = append(sc.viewports, vp)
+}
+
+// RemoveViewport removes specified viewport from the screen.
+func (glpeer *GLPeer) RemoveViewport(vp *Viewport) {
+	sc := glpeer.getScene()
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	var list []*Viewport
+	for _, v := range sc.viewports {
+		if v != vp {
+			list = append(list, v)
+		}
+	}
+	sc.viewports = list
+}
+
+// RemoveAllViewports removes all viewports. Whole screen is rendered
+// through the main camera again.
+func (glpeer *GLPeer) RemoveAllViewports() {
+	sc := glpeer.getScene()
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.viewports = nil
+}
+
+// activeViewports returns viewports to render
+func (sc *scene) activeViewports() []*Viewport {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if len(sc.viewports) == 0 {
+		return []*Viewport{sc.defaultViewport}
+	}
+	list := make([]*Viewport, len(sc.viewports))
+	copy(list, sc.viewports)
+	return list
+}
+
+// viewportAt returns the front-most viewport that contains specified position
+// in virtual screen coordinates. nil if no viewport contains the position.
+func (sc *scene) viewportAt(x, y float32) *Viewport {
+	list := sc.activeViewports()
+	if list[0] == sc.defaultViewport {
+		return sc.defaultViewport
+	}
+	for i := len(list) - 1; i >= 0; i-- {
+		if list[i].contains(x, y) {
+			return list[i]
+		}
+	}
+	return nil
+}
+
+// cameraAt returns camera of the viewport that contains specified position
+// in virtual screen coordinates, such as touched position.
+// The main camera is returned if no viewport contains the position.
+func (sc *scene) cameraAt(x, y float32) *Camera {
+	if vp := sc.viewportAt(x, y); vp != nil {
+		return vp.camera
+	}
+	return sc.camera
+}
+
+// CameraAt returns camera of the viewport that contains specified position
+// in virtual screen coordinates, among viewports of the scene that sprite
+// is added to. The main camera is returned if no viewport contains the position.
+func (s *Sprite) CameraAt(x, y float32) *Camera {
+	return s.getScene().cameraAt(x, y)
+}
@@ -0,0 +1,93 @@
+package peer
+
+import (
+	"testing"
+
+	"golang.org/x/mobile/event/size"
+)
+
+func TestViewportAt(t *testing.T) {
+	glpeer := &GLPeer{}
+	sc := glpeer.getScene()
+	if vp := sc.viewportAt(10, 10); vp != sc.defaultViewport {
+		t.Errorf("default viewport should be returned while no viewport is added")
+	}
+
+	left := NewViewport(0, 0, 100, 100)
+	right := NewViewport(100, 0, 100, 100)
+	minimap := NewViewport(80, 80, 20, 20)
+	glpeer.AddViewport(left)
+	glpeer.AddViewport(right)
+	glpeer.AddViewport(minimap)
+
+	tcs := []struct {
+		x, y float32
+		want *Viewport
+	}{
+		{x: 10, y: 10, want: left},
+		{x: 150, y: 10, want: right},
+		// later viewport is in front
+		{x: 90, y: 90, want: minimap},
+		{x: 250, y: 10, want: nil},
+	}
+	for i, tc := range tcs {
+		if vp := sc.viewportAt(tc.x, tc.y); vp != tc.want {
+			t.Errorf("[%d] unexpected viewport", i)
+		}
+	}
+
+	glpeer.RemoveViewport(minimap)
+	if vp := sc.viewportAt(90, 90); vp != left {
+		t.Errorf("removed viewport should not be returned")
+	}
+	if sc.cameraAt(250, 10) != glpeer.GetCamera() {
+		t.Errorf("main camera should be returned outside of viewports")
+	}
+}
+
+func TestViewportTouch(t *testing.T) {
+	glpeer := &GLPeer{}
+	left := NewViewport(0, 0, 100, 100)
+	right := NewViewport(100, 0, 100, 100)
+	glpeer.AddViewport(left)
+	glpeer.AddViewport(right)
+	// both cameras look at (1000, 1000)
+	left.GetCamera().SetPosition(1000, 1000)
+	right.GetCamera().SetPosition(1000, 1000)
+	right.SetLayerMask(2)
+
+	s := &Sprite{X: 1000, Y: 1000, W: 10, H: 10}
+	glpeer.AppendNode(&ZNode{sprite: s})
+	if !s.Contains(50, 50) {
+		t.Errorf("sprite should be hit in left viewport")
+	}
+	if s.Contains(150, 50) {
+		t.Errorf("sprite should not be hit in right viewport by layer mask")
+	}
+	s.SetLayerBits(3)
+	if !s.Contains(150, 50) {
+		t.Errorf("sprite should be hit in right viewport")
+	}
+}
+
+func TestLayerBits(t *testing.T) {
+	s := &Sprite{}
+	if s.GetLayerBits() != 1 {
+		t.Errorf("unexpected result. [got] %d [want] %d", s.GetLayerBits(), 1)
+	}
+	s.SetLayerBits(0)
+	if s.GetLayerBits() != 0 {
+		t.Errorf("unexpected result. [got] %d [want] %d", s.GetLayerBits(), 0)
+	}
+}
+
+func TestScissorRect(t *testing.T) {
+	ss := &screenSize{}
+	ss.SetDesiredScreenSize(200, 100)
+	ss.SetScreenSize(size.Event{WidthPx: 400, HeightPx: 200, WidthPt: 200, HeightPt: 100, PixelsPerPt: 2})
+
+	x, y, w, h := ss.scissorRect(100, 0, 100, 50)
+	if x != 200 || y != 0 || w != 200 || h != 100 {
+		t.Errorf("unexpected result. [got] (%d, %d, %d, %d) [want] (%d, %d, %d, %d)", x, y, w, h, 200, 0, 200, 100)
+	}
+}
@@ -0,0 +1,80 @@
+package simra
+
+import (
+	"github.com/pankona/gomo-simra/simra/internal/peer"
+	"github.com/pankona/gomo-simra/simra/simlog"
+)
+
+// Layer represents a named group of sprites that are rendered together.
+// Layers are rendered in ascending order, so that a layer that has greater
+// order is drawn over the others. Sprites belong to the default layer,
+// whose name is DefaultLayerName and order is 0, unless SetLayer is called.
+type Layer interface {
+	// GetName returns name of the layer.
+	GetName() string
+	// GetOrder returns order of the layer.
+	GetOrder() int
+	// SetSortMode sets how sprites in the layer are sorted. Default is SortByZIndex.
+	SetSortMode(mode SortMode)
+	// GetSortMode gets how sprites in the layer are sorted.
+	GetSortMode() SortMode
+	// SetVisible sets visibility of the layer.
+	// Sprites in hidden layer are not rendered, and not touched.
+	SetVisible(visible bool)
+	// IsVisible returns true if the layer is visible.
+	IsVisible() bool
+}
+
+// SortMode represents how sprites in a layer are sorted for rendering.
+type SortMode = peer.SortMode
+
+const (
+	// SortByZIndex sorts sprites by zindex. Sprite that has lesser zindex is in front.
+	SortByZIndex = peer.SortByZIndex
+	// SortByY sorts sprites by y position every frame. Sprite that has lesser y
+	// is in front. This is useful for top-down games.
+	SortByY = peer.SortByY
+	// SortByInsertion draws sprites in added order. zindex is ignored.
+	SortByInsertion = peer.SortByInsertion
+)
+
+// DefaultLayerName is name of the layer that sprites belong to by default.
+const DefaultLayerName = peer.DefaultLayerName
+
+// AddLayer adds a layer that has specified name and order to current scene.
+// Layer that has greater order is drawn over the others.
+// Layers are removed when scene is changed.
+func (sim *simra) AddLayer(name string, order int) (Layer, error) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+	l, err := sim.gl.AddLayer(name, order)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// GetLayer returns the layer that has specified name. nil if not found.
+func (sim *simra) GetLayer(name string) Layer {
+	l := sim.gl.GetLayer(name)
+	if l == nil {
+		// avoid returning non-nil interface holding nil pointer
+		return nil
+	}
+	return l
+}
+
+// SetLayer sets the layer that sprite belongs to.
+// nil means the default layer.
+func (sprite *sprite) SetLayer(l Layer) {
+	if l == nil {
+		sprite.Sprite.SetLayer(nil)
+		return
+	}
+	sprite.Sprite.SetLayer(l.(*peer.Layer))
+}
+
+// GetLayer gets the layer that sprite belongs to.
+func (sprite *sprite) GetLayer() Layer {
+	return sprite.Sprite.GetLayer()
+}
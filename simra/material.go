@@ -0,0 +1,128 @@
+package simra
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/pankona/gomo-simra/simra/internal/peer"
+	"github.com/pankona/gomo-simra/simra/simlog"
+)
+
+// Material represents a fragment shader effect applied to sprites.
+//
+// Source of material is GLSL ES 1.0 that declares uniforms and a function
+//
+//	vec4 effect(sampler2D tex, vec2 uv)
+//
+// that returns premultiplied color at uv of sprite's texture.
+// The returned color is multiplied by sprite's alpha and tint.
+// The function can use following built-in variables and functions:
+//
+//	uniform vec2 texelSize;  // size of a texel in texture coordinates
+//	uniform vec4 uvRect;     // sub rectangle of texture drawn by sprite
+//	vec2 toUnit(vec2 uv);    // converts uv to 0..1 of the sub rectangle
+//	vec4 sampleRect(sampler2D tex, vec2 uv); // transparent outside of the sub rectangle
+type Material interface {
+	// SetFloat sets a float uniform
+	SetFloat(name string, v float32)
+	// GetFloat gets a float uniform. 0 if not set.
+	GetFloat(name string) float32
+	// SetVec2 sets a vec2 uniform
+	SetVec2(name string, x, y float32)
+	// SetVec4 sets a vec4 uniform
+	SetVec4(name string, x, y, z, w float32)
+	// SetColor sets a vec4 uniform by color. Components are premultiplied and in 0..1.
+	SetColor(name string, c color.RGBA)
+	// SetTexture binds specified texture to a sampler2D uniform.
+	// Sampling coordinates are 0..1 of whole texture, whose size is
+	// the image's size rounded up to power of 2.
+	SetTexture(name string, t *Texture)
+	// SetImage binds specified image to a sampler2D uniform.
+	// Sampling coordinates are 0..1 of the image if its size is power of 2.
+	SetImage(name string, img image.Image)
+}
+
+type material struct {
+	*peer.Material
+	// textures retains bound textures to avoid to be discarded by GC
+	textures map[string]*Texture
+}
+
+func newMaterial(m *peer.Material) *material {
+	return &material{
+		Material: m,
+		textures: make(map[string]*Texture),
+	}
+}
+
+func (m *material) SetTexture(name string, t *Texture) {
+	m.textures[name] = t
+	m.Material.SetTexture(name, t.texture)
+}
+
+// NewMaterial compiles specified source and returns a material.
+// Error is returned if compilation failed.
+func (sim *simra) NewMaterial(source string) (Material, error) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+	m := peer.NewMaterial(source)
+	if err := sim.gl.CompileMaterial(m); err != nil {
+		return nil, err
+	}
+	return newMaterial(m), nil
+}
+
+// NewGrayscaleMaterial returns a material that desaturates sprite, such as disabled button.
+// Float uniform "amount" is the ratio of desaturation in 0..1.
+func NewGrayscaleMaterial(amount float32) Material {
+	return newMaterial(peer.NewGrayscale(amount))
+}
+
+// NewOutlineMaterial returns a material that draws 1 texel outline around opaque
+// area of sprite. Since outline is drawn inside of sprite, texture needs transparent margin.
+// Color uniform "outlineColor" is color of the outline.
+func NewOutlineMaterial(c color.RGBA) Material {
+	return newMaterial(peer.NewOutline(c))
+}
+
+// NewDissolveMaterial returns a material that dissolves sprite by noise.
+// Float uniform "progress" is the ratio of dissolved area in 0..1, and
+// texels near the border of dissolved area are drawn in edge color.
+// Uniforms "edgeWidth" and "edgeColor" configures the edge.
+func NewDissolveMaterial(progress float32, edge color.RGBA, seed int64) Material {
+	return newMaterial(peer.NewDissolve(progress, edge, seed))
+}
+
+// NewPaletteSwapMaterial returns a material that replaces colors of sprite by the palette.
+// Red channel of texture is used as index of the palette, that is,
+// 0 is the first color and 255 is the last color of the palette.
+func NewPaletteSwapMaterial(palette []color.RGBA) Material {
+	return newMaterial(peer.NewPaletteSwap(palette))
+}
+
+// NewHitFlashMaterial returns a material that fills opaque area of sprite with
+// flash color, such as a character taking damage. Float uniform "amount" is
+// the ratio of flash color in 0..1, and color uniform "flashColor" is the color.
+func NewHitFlashMaterial(c color.RGBA, amount float32) Material {
+	return newMaterial(peer.NewHitFlash(c, amount))
+}
+
+// SetMaterial sets material that draws sprite. nil means the default drawing.
+func (sprite *sprite) SetMaterial(m Material) {
+	if m == nil {
+		sprite.material = nil
+		sprite.Sprite.SetMaterial(nil)
+		return
+	}
+	sprite.material = m.(*material)
+	sprite.Sprite.SetMaterial(sprite.material.Material)
+}
+
+// GetMaterial gets material that draws sprite. nil if not set.
+func (sprite *sprite) GetMaterial() Material {
+	if sprite.material == nil {
+		// avoid returning non-nil interface holding nil pointer
+		return nil
+	}
+	return sprite.material
+}
@@ -0,0 +1,109 @@
+package simra
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/pankona/gomo-simra/simra/internal/peer"
+	"github.com/pankona/gomo-simra/simra/simlog"
+)
+
+// RenderTarget represents an offscreen image that sprites are rendered into.
+// Rendered image can be used as a texture of sprites, such as pre-composited
+// background, minimap, or snapshot of the scene for transitions.
+type RenderTarget interface {
+	// SetSourceRect sets rectangle in world coordinates that is rendered to whole target.
+	// (x, y) is bottom left of the rectangle. Default is (0, 0, width, height) of the target.
+	SetSourceRect(x, y, w, h float32)
+	// GetSourceRect gets rectangle in world coordinates that is rendered to whole target.
+	GetSourceRect() (x, y, w, h float32)
+	// AddSprite adds specified sprite and its children to the sprites to render.
+	AddSprite(s Spriter)
+	// AddLayer adds sprites of specified layer to the sprites to render.
+	// They are rendered even if the layer is hidden, so that a hidden layer
+	// can be used to compose sprites only for render target.
+	AddLayer(l Layer)
+	// ClearSources removes all sprites and layers to render.
+	ClearSources()
+	// SetClearColor sets color that fills the target before rendering.
+	// Default is transparent.
+	SetClearColor(c color.RGBA)
+	// Render renders the sprites into the target. Rendering is done once per call,
+	// so that the target keeps the image until Render is called again.
+	// Rendering on GPU is done at the beginning of next frame.
+	Render()
+	// GetTexture returns a texture that shows rendered image.
+	// Assign it to sprites by ReplaceTexture.
+	GetTexture() *Texture
+	// Image returns rendered image read back to CPU.
+	// The image is updated by rendering.
+	Image() *image.RGBA
+	// Release releases the target. The target and its texture can't be used after release.
+	Release()
+}
+
+type renderTarget struct {
+	simra *simra
+	peer  *peer.RenderTarget
+}
+
+// NewRenderTarget returns a render target that has specified size in pixels.
+// Render targets are released when scene is changed.
+func (sim *simra) NewRenderTarget(width, height int) (RenderTarget, error) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	rt, err := sim.gl.NewRenderTarget(width, height)
+	if err != nil {
+		return nil, err
+	}
+	return &renderTarget{
+		simra: sim,
+		peer:  rt,
+	}, nil
+}
+
+func (rt *renderTarget) SetSourceRect(x, y, w, h float32) {
+	rt.peer.SetSourceRect(x, y, w, h)
+}
+
+func (rt *renderTarget) GetSourceRect() (x, y, w, h float32) {
+	return rt.peer.GetSourceRect()
+}
+
+func (rt *renderTarget) AddSprite(s Spriter) {
+	rt.peer.AddSprite(&asSprite(s).Sprite)
+}
+
+func (rt *renderTarget) AddLayer(l Layer) {
+	rt.peer.AddLayer(l.(*peer.Layer))
+}
+
+func (rt *renderTarget) ClearSources() {
+	rt.peer.ClearSources()
+}
+
+func (rt *renderTarget) SetClearColor(c color.RGBA) {
+	rt.peer.SetClearColor(c)
+}
+
+func (rt *renderTarget) Render() {
+	rt.simra.applyShapes()
+	rt.simra.gl.RenderTo(rt.peer)
+}
+
+func (rt *renderTarget) GetTexture() *Texture {
+	// texture is released with the target, not by finalizer
+	return &Texture{
+		simra:   rt.simra,
+		texture: rt.peer.Texture(),
+	}
+}
+
+func (rt *renderTarget) Image() *image.RGBA {
+	return rt.peer.Image()
+}
+
+func (rt *renderTarget) Release() {
+	rt.simra.gl.ReleaseRenderTarget(rt.peer)
+}
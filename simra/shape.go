@@ -0,0 +1,205 @@
+package simra
+
+import (
+	"image/color"
+	"runtime"
+
+	"github.com/pankona/gomo-simra/simra/internal/peer"
+	"github.com/pankona/gomo-simra/simra/simlog"
+)
+
+// Shape represents a sprite that shows a vector shape.
+// Geometry is given relative to sprite's position, and y axis goes upward.
+// The shape is rasterized into sprite's texture at the next frame after its
+// geometry or style is changed, and sprite's size is set to contain the shape.
+// Outline is drawn on both sides of edges, and segments are joined roundly.
+type Shape interface {
+	Spriter
+	// SetRect makes the shape a rectangle of w x h centered at the position
+	SetRect(w, h float32)
+	// SetRoundedRect makes the shape a rectangle of w x h centered at the position,
+	// whose corners are rounded by radius
+	SetRoundedRect(w, h, radius float32)
+	// SetCircle makes the shape a circle of radius r centered at the position
+	SetCircle(r float32)
+	// SetEllipse makes the shape an ellipse centered at the position,
+	// whose radii are rx and ry
+	SetEllipse(rx, ry float32)
+	// SetLine makes the shape a line from (x0, y0) to (x1, y1).
+	// Width of the line is stroke width.
+	SetLine(x0, y0, x1, y1 float32)
+	// SetPolyline makes the shape segments connecting points.
+	// Polyline is not filled.
+	SetPolyline(points []Position)
+	// SetPolygon makes the shape a polygon whose vertices are points
+	SetPolygon(points []Position)
+	// SetFillColor sets color of inside of the shape.
+	// Transparent means no fill. Default is white.
+	SetFillColor(c color.RGBA)
+	// GetFillColor gets color of inside of the shape
+	GetFillColor() color.RGBA
+	// SetStrokeColor sets color of outline of the shape. Default is black.
+	SetStrokeColor(c color.RGBA)
+	// GetStrokeColor gets color of outline of the shape
+	GetStrokeColor() color.RGBA
+	// SetStrokeWidth sets width of outline of the shape.
+	// Outline is not drawn if 0. Default is 0.
+	SetStrokeWidth(w float32)
+	// GetStrokeWidth gets width of outline of the shape
+	GetStrokeWidth() float32
+	// SetResolution sets the number of texture pixels per unit of virtual screen.
+	// Increase it to keep edges smooth when the shape is scaled up. Default is 1.
+	SetResolution(ppu float32)
+}
+
+// shapeSprite is a sprite that shows a vector shape
+type shapeSprite struct {
+	*sprite
+	shape peer.Shape
+	// ppu is the number of pixels per unit
+	ppu float32
+	// raster is the texture the shape is rasterized into
+	raster *Texture
+	// dirty is true if the shape is changed after it is rasterized
+	dirty bool
+}
+
+// NewShape returns a sprite that shows a vector shape.
+// Add it to scene by AddSprite, then set its geometry.
+func (sim *simra) NewShape() Shape {
+	sh := &shapeSprite{
+		sprite: sim.NewSprite().(*sprite),
+		shape: peer.Shape{
+			Fill:   color.RGBA{255, 255, 255, 255},
+			Stroke: color.RGBA{0, 0, 0, 255},
+		},
+		ppu: 1,
+	}
+	// parent and children of the shape are looked up as a shape
+	sh.SetOwner(sh)
+	return sh
+}
+
+// invalidate marks the shape to be rasterized at the next frame
+func (sh *shapeSprite) invalidate() {
+	if sh.dirty {
+		return
+	}
+	sh.dirty = true
+	sim := sh.simra
+	sim.dirtyShapes = append(sim.dirtyShapes, sh)
+}
+
+// applyShapes rasterizes shapes changed since the last frame
+func (sim *simra) applyShapes() {
+	for _, sh := range sim.dirtyShapes {
+		sh.redraw()
+	}
+	sim.dirtyShapes = sim.dirtyShapes[:0]
+}
+
+// redraw rasterizes the shape into its texture
+func (sh *shapeSprite) redraw() {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	if !sh.dirty {
+		return
+	}
+	sh.dirty = false
+	var old *peer.Texture
+	if sh.raster != nil {
+		old = sh.raster.texture
+	}
+	t, w, h := sh.simra.gl.DrawShape(old, &sh.shape, sh.ppu)
+	if t == nil {
+		// nothing to draw
+		sh.SetScale(0, 0)
+		return
+	}
+	if t != old {
+		if sh.raster != nil {
+			sh.raster.Release()
+		}
+		sh.raster = &Texture{simra: sh.simra, texture: t}
+		runtime.SetFinalizer(sh.raster, (*Texture).release)
+		sh.ReplaceTexture(sh.raster)
+	}
+	sh.SetScale(w, h)
+}
+
+// setPolyline sets points of the shape
+func (sh *shapeSprite) setPolyline(points []Position, closed bool) {
+	xy := make([]float32, 0, len(points)*2)
+	for _, p := range points {
+		xy = append(xy, p.X, p.Y)
+	}
+	sh.shape.SetPolyline(xy, closed)
+	sh.invalidate()
+}
+
+func (sh *shapeSprite) SetRect(w, h float32) {
+	sh.SetRoundedRect(w, h, 0)
+}
+
+func (sh *shapeSprite) SetRoundedRect(w, h, radius float32) {
+	sh.shape.SetRect(w, h, radius)
+	sh.invalidate()
+}
+
+func (sh *shapeSprite) SetCircle(r float32) {
+	sh.SetEllipse(r, r)
+}
+
+func (sh *shapeSprite) SetEllipse(rx, ry float32) {
+	sh.shape.SetEllipse(rx, ry)
+	sh.invalidate()
+}
+
+func (sh *shapeSprite) SetLine(x0, y0, x1, y1 float32) {
+	sh.setPolyline([]Position{{x0, y0}, {x1, y1}}, false)
+}
+
+func (sh *shapeSprite) SetPolyline(points []Position) {
+	sh.setPolyline(points, false)
+}
+
+func (sh *shapeSprite) SetPolygon(points []Position) {
+	sh.setPolyline(points, true)
+}
+
+func (sh *shapeSprite) SetFillColor(c color.RGBA) {
+	sh.shape.Fill = c
+	sh.invalidate()
+}
+
+func (sh *shapeSprite) GetFillColor() color.RGBA {
+	return sh.shape.Fill
+}
+
+func (sh *shapeSprite) SetStrokeColor(c color.RGBA) {
+	sh.shape.Stroke = c
+	sh.invalidate()
+}
+
+func (sh *shapeSprite) GetStrokeColor() color.RGBA {
+	return sh.shape.Stroke
+}
+
+func (sh *shapeSprite) SetStrokeWidth(w float32) {
+	sh.shape.StrokeWidth = w
+	sh.invalidate()
+}
+
+func (sh *shapeSprite) GetStrokeWidth() float32 {
+	return sh.shape.StrokeWidth
+}
+
+func (sh *shapeSprite) SetResolution(ppu float32) {
+	if ppu <= 0 {
+		simlog.Errorf("resolution must be positive. [got] %f", ppu)
+		return
+	}
+	sh.ppu = ppu
+	sh.invalidate()
+}
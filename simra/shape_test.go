@@ -0,0 +1,66 @@
+package simra
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/pankona/gomo-simra/simra/internal/peer"
+)
+
+// mockShapeGL is a GLer that counts rasterization of shapes
+type mockShapeGL struct {
+	peer.GLer
+	drawn int
+	tex   *peer.Texture
+}
+
+func (m *mockShapeGL) DrawShape(t *peer.Texture, sh *peer.Shape, ppu float32) (*peer.Texture, float32, float32) {
+	m.drawn++
+	return m.tex, 10, 20
+}
+
+func (m *mockShapeGL) DiscardTexture(t *peer.Texture) {}
+
+func TestShapeRasterizedOncePerFrame(t *testing.T) {
+	gl := &mockShapeGL{tex: &peer.Texture{}}
+	sc := &mockPoolContainer{added: make(map[*peer.Sprite]bool)}
+	sim := &simra{gl: gl, spritecontainer: sc}
+
+	s := sim.NewShape()
+	sim.AddSprite(s)
+	s.SetRect(4, 8)
+	s.SetFillColor(color.RGBA{R: 255, A: 255})
+	s.SetStrokeWidth(2)
+	if gl.drawn != 0 {
+		t.Errorf("shape should not be rasterized by setters. [got] %d", gl.drawn)
+	}
+
+	sim.applyShapes()
+	if gl.drawn != 1 {
+		t.Errorf("unexpected result. [got] %d [want] %d", gl.drawn, 1)
+	}
+	want := Scale{W: 10, H: 20}
+	if got := s.GetScale(); got != want {
+		t.Errorf("unexpected result. [got] %v [want] %v", got, want)
+	}
+
+	// nothing is changed
+	sim.applyShapes()
+	if gl.drawn != 1 {
+		t.Errorf("unexpected result. [got] %d [want] %d", gl.drawn, 1)
+	}
+
+	s.SetCircle(3)
+	sim.applyShapes()
+	if gl.drawn != 2 {
+		t.Errorf("unexpected result. [got] %d [want] %d", gl.drawn, 2)
+	}
+}
+
+func TestSpriteIsNotShape(t *testing.T) {
+	sim := &simra{}
+	var s interface{} = sim.NewSprite()
+	if _, ok := s.(Shape); ok {
+		t.Errorf("image sprite should not be a shape")
+	}
+}
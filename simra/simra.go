@@ -1,6 +1,7 @@
 package simra
 
 import (
+	goimage "image"
 	"image/color"
 	"runtime"
 
@@ -45,8 +46,56 @@ type Simraer interface {
 	AddTouchListener(listener TouchListener)
 	// RemoveTouchListener unregisters a listener for notifying touch event.
 	RemoveTouchListener(listener TouchListener)
+	// AddMouseListener registers a listener for notifying mouse event.
+	// Event is notified when mouse is moved, clicked or its wheel is scrolled
+	// on the "screen". Mouse events are delivered on Linux (X11). Clicks are
+	// notified as touches as well, and only as touches on other platforms.
+	AddMouseListener(listener MouseListener)
+	// RemoveMouseListener unregisters a listener for notifying mouse event.
+	RemoveMouseListener(listener MouseListener)
+	// AddKeyListener registers a listener for notifying key event.
+	AddKeyListener(listener KeyListener)
+	// RemoveKeyListener unregisters a listener for notifying key event.
+	RemoveKeyListener(listener KeyListener)
+	// AddActionMap registers an ActionMap to current scene.
+	// Registered ActionMap receives input events and
+	// is updated every frame before Drive is called.
+	// All ActionMaps are unregistered at changing of scene.
+	AddActionMap(am *ActionMap)
+	// RemoveActionMap unregisters specified ActionMap.
+	RemoveActionMap(am *ActionMap)
+	// AddDraggable makes specified sprite draggable.
+	// Dragging starts when touch on the sprite moves more than threshold,
+	// then the sprite follows the touch keeping the offset from touched position.
+	// When the sprite is dropped on a drop target, the target can accept or
+	// reject it. If rejected or dropped on no target, the sprite snaps back.
+	// listener can be nil if drag events are not necessary.
+	AddDraggable(s Spriter, threshold float32, listener DragListener)
+	// RemoveDraggable makes specified sprite not draggable.
+	RemoveDraggable(s Spriter)
+	// AddDropTarget registers specified sprite as a drop target.
+	// listener is notified when a dragged sprite comes over, goes out of,
+	// or is dropped on the target.
+	AddDropTarget(s Spriter, listener DropTargetListener)
+	// RemoveDropTarget unregisters specified drop target.
+	RemoveDropTarget(s Spriter)
+	// GetCamera returns the camera of current scene.
+	// Camera is reset when scene is changed.
+	GetCamera() Camera
+	// AddViewport adds a viewport that occupies specified rectangle of virtual screen.
+	// While no viewport is added, whole screen is rendered through the camera
+	// returned by GetCamera. Once a viewport is added, only added viewports are rendered.
+	AddViewport(x, y, w, h float32) Viewport
+	// RemoveViewport removes specified viewport from screen.
+	RemoveViewport(v Viewport)
+	// AddLayer adds a layer that has specified name and order to current scene.
+	// Layer that has greater order is drawn over the others.
+	AddLayer(name string, order int) (Layer, error)
+	// GetLayer returns the layer that has specified name. nil if not found.
+	GetLayer(name string) Layer
 	// AddCollisionListener add a callback function that is called on
 	// collision is detected between c1 and c2.
+	// A collider that has IsVisible method, like Spriter, doesn't collide while hidden.
 	AddCollisionListener(c1, c2 Collider, listener CollisionListener)
 	// RemoveAllCollisionListener removes all registered listeners
 	RemoveAllCollisionListener()
@@ -54,13 +103,90 @@ type Simraer interface {
 	NewImageTexture(assetName string, rect image.Rectangle) *Texture
 	// NewImageTexture returns a texture instance of text
 	NewTextTexture(text string, fontsize float64, fontcolor color.RGBA, rect image.Rectangle) *Texture
+	// NewTextureFromImage returns a texture that has pixels of specified image.
+	// This is useful for images generated at runtime, such as minimaps and QR codes.
+	// The image must not be modified since it is referred to restore the texture.
+	NewTextureFromImage(img goimage.Image) *Texture
+	// NewTextureFromBytes returns a texture of image encoded in specified bytes,
+	// such as an avatar downloaded from a server. Format is detected from the content.
+	NewTextureFromBytes(b []byte) (*Texture, error)
+	// NewNinePatchTexture loads Android's nine-patch image (.9.png) and returns
+	// its texture and insets, that can be set to sprite by SetNineSlice
+	NewNinePatchTexture(assetName string) (*Texture, *NineSlice, error)
+	// NewAtlas loads TexturePacker's JSON data and returns an atlas of its frames
+	NewAtlas(assetName string) (*Atlas, error)
+	// NewRenderTarget returns a render target that has specified size in pixels.
+	// Sprites rendered into the target can be used as a texture.
+	// An error is returned if size is not positive.
+	NewRenderTarget(width, height int) (RenderTarget, error)
+	// NewSpritePool returns a pool that recycles sprites, such as bullets.
+	// init is called for every sprite created by the pool to set its texture and so on.
+	NewSpritePool(init func(s Spriter)) SpritePool
+	// NewShape returns a sprite that shows a vector shape, such as rectangles,
+	// circles and lines. The shape is rasterized into its texture.
+	NewShape() Shape
+	// NewMaterial compiles specified GLSL ES source and returns a material.
+	// Error is returned if compilation failed.
+	NewMaterial(source string) (Material, error)
 	// SetOnStopCallback sets a callback function that will be called on application goes invisible
 	SetOnStopCallback(f func())
+	// GetRenderStats returns the number of sprites drawn and culled in the last frame.
+	// Sprites out of the screen, including letterbox margins, are culled.
+	GetRenderStats() RenderStats
+	// GetTextureStats returns the number and memory usage of textures loaded by NewImageTexture.
+	// Textures of the same asset are shared until all of them are released.
+	GetTextureStats() TextureStats
+	// GetLiveTextures returns textures held by GL with their sources, such as asset name.
+	// Textures that are not released are listed. This is useful to find leaks.
+	GetLiveTextures() []TextureInfo
 }
 
 // TouchListener is interface to receive touch event
 type TouchListener peer.TouchListener
 
+// TouchConsumer is an optional interface for TouchListener registered to sprite.
+// If a listener implements this interface, ConsumeTouch is called right after
+// the listener is notified a touch event. Returning true stops propagation of
+// the event to the sprites behind.
+type TouchConsumer peer.TouchConsumer
+
+// MultiTouchListener is an optional interface for TouchListener to tell
+// simultaneous touches apart. If a listener implements this interface,
+// its methods are called with id of the touch instead of TouchListener's.
+type MultiTouchListener peer.MultiTouchListener
+
+// MouseListener is interface to receive mouse event
+type MouseListener peer.MouseListener
+
+// KeyListener is interface to receive key event
+type KeyListener peer.KeyListener
+
+// PointerListener is interface to receive hover event of sprite
+type PointerListener peer.PointerListener
+
+// MouseButton represents a button of mouse
+type MouseButton = peer.MouseButton
+
+// RenderStats represents statistics of rendering of a frame for profiling
+type RenderStats = peer.RenderStats
+
+// TextureStats represents statistics of textures shared by asset name
+type TextureStats = peer.TextureStats
+
+// TextureInfo represents a texture held by GL
+type TextureInfo = peer.TextureInfo
+
+const (
+	// MouseButtonNone represents that no button is related to the event
+	MouseButtonNone = peer.MouseButtonNone
+	// MouseButtonLeft represents left button of mouse
+	MouseButtonLeft = peer.MouseButtonLeft
+	// MouseButtonMiddle represents middle button (wheel click) of mouse
+	MouseButtonMiddle = peer.MouseButtonMiddle
+	// MouseButtonRight represents right button of mouse
+	MouseButtonRight = peer.MouseButtonRight
+)
+
 type collisionMap struct {
 	c1       Collider
 	c2       Collider
@@ -73,7 +199,16 @@ type simra struct {
 	comap           []*collisionMap
 	gl              peer.GLer
 	spritecontainer peer.SpriteContainerer
+	actionMaps      []*ActionMap
+	draggables      []*draggable
+	dropTargets     []*dropTarget
+	camera          *camera
+	viewports       []*viewport
 	onStop          func()
+	// dirtyShapes are shapes to be rasterized at the next frame
+	dirtyShapes []*shapeSprite
+	// started is true once GL context is given at first
+	started bool
 }
 
 // NewSimra returns an instance of Simraer
@@ -84,24 +219,42 @@ func NewSimra() Simraer {
 }
 
 func (sim *simra) onUpdate() {
+	for _, am := range sim.actionMaps {
+		am.update()
+	}
 	if sim.driver != nil {
 		sim.driver.Drive()
 	}
+	if sim.camera != nil {
+		sim.camera.update()
+	}
+	for _, v := range sim.viewports {
+		v.camera.update()
+	}
 	sim.collisionCheckAndNotify()
+	sim.applyShapes()
 	sim.gl.Update(sim.spritecontainer)
 }
 
-func (sim *simra) onGomoStart(glc *peer.GLContext) {
-	sim.gl.Initialize(glc)
+func (sim *simra) onGomoStart(glc *peer.GLContext) error {
+	if sim.started {
+		// GL context was lost while the application was invisible.
+		// current scene continues with restored textures.
+		return sim.gl.Resume(glc)
+	}
+	if err := sim.gl.Initialize(glc); err != nil {
+		return err
+	}
+	sim.started = true
 	sim.SetScene(sim.driver)
+	return nil
 }
 
 func (sim *simra) onGomoStop() {
 	if sim.onStop != nil {
 		sim.onStop()
 	}
-	sim.spritecontainer.Initialize(sim.gl)
-	sim.gl.Finalize()
+	sim.gl.Suspend()
 }
 
 // Start starts to run gomobile and set specified scene as first driver
@@ -127,9 +280,25 @@ func (sim *simra) SetScene(driver Driver) {
 	simlog.FuncIn()
 
 	sim.spritecontainer.RemoveSprites()
-	sim.gl.Reset()
+	sim.dirtyShapes = nil
+	if err := sim.gl.Reset(); err != nil {
+		simlog.Errorf("failed to reset GL. err: %s", err.Error())
+		return
+	}
 	sim.spritecontainer.Initialize(sim.gl)
 	peer.GetTouchPeer().RemoveAllTouchListeners()
+	peer.GetMousePeer().RemoveAllMouseListeners()
+	peer.GetKeyPeer().RemoveAllKeyListeners()
+	sim.actionMaps = nil
+	for _, d := range sim.draggables {
+		d.sprite.RemoveTouchListener(d)
+	}
+	sim.draggables = nil
+	sim.dropTargets = nil
+	sim.camera = newCamera(sim.gl.GetCamera())
+	sim.viewports = nil
+	sim.gl.RemoveAllViewports()
+	sim.gl.RemoveAllLayers()
 	sim.spritecontainer.RemoveSprites()
 
 	sim.driver = driver
@@ -147,25 +316,32 @@ func (sim *simra) SetScene(driver Driver) {
 
 // NewSprite returns an instance of Sprite
 func (sim *simra) NewSprite() Spriter {
-	return &sprite{
+	s := &sprite{
 		simra:         sim,
 		animationSets: map[string]*AnimationSet{},
 	}
+	s.SetOwner(s)
+	return s
 }
 
 // AddSprite adds a sprite to current scene with empty texture.
 func (sim *simra) AddSprite(s Spriter) {
-	sp := s.(*sprite)
+	sp := asSprite(s)
 	err := sim.spritecontainer.AddSprite(&sp.Sprite, nil, nil)
 	if err != nil {
 		simlog.Errorf("failed to add sprite. err: %s", err.Error())
+		return
+	}
+	if sh, ok := s.(*shapeSprite); ok && sh.raster != nil {
+		// shape may be rasterized before the sprite is added
+		sp.ReplaceTexture(sh.raster)
 	}
 }
 
 // RemoveSprite removes specified sprite from current scene.
 // Removed sprite will be disappeared.
 func (sim *simra) RemoveSprite(s Spriter) {
-	sp := s.(*sprite)
+	sp := asSprite(s)
 	sp.texture = nil
 	sim.spritecontainer.RemoveSprite(&sp.Sprite)
 }
@@ -178,7 +354,7 @@ func (sim *simra) RemoveSprite(s Spriter) {
 // If specified sprite is not found (removed or not added yet),
 // this function returns non-nil error.
 func (sim *simra) SetZIndex(s Spriter, z int) error {
-	sp := s.(*sprite)
+	sp := asSprite(s)
 	return sim.spritecontainer.SetZIndex(&sp.Sprite, z)
 }
 
@@ -187,7 +363,7 @@ func (sim *simra) SetZIndex(s Spriter, z int) error {
 // If specified sprite is not found (removed or not added yet),
 // this function returns non-nil error.
 func (sim *simra) GetZIndex(s Spriter) (int, error) {
-	sp := s.(*sprite)
+	sp := asSprite(s)
 	return sim.spritecontainer.GetZIndex(&sp.Sprite)
 }
 
@@ -209,6 +385,56 @@ func (sim *simra) RemoveTouchListener(listener TouchListener) {
 	peer.GetTouchPeer().RemoveTouchListener(listener)
 }
 
+// AddMouseListener registers a listener for notifying mouse event.
+// Event is notified when mouse is moved, clicked or its wheel is scrolled
+// on the "screen". Mouse events are delivered on Linux (X11). Clicks are
+// notified as touches as well, and only as touches on other platforms.
+func (sim *simra) AddMouseListener(listener MouseListener) {
+	peer.GetMousePeer().AddMouseListener(listener)
+}
+
+// RemoveMouseListener unregisters a listener for notifying mouse event.
+func (sim *simra) RemoveMouseListener(listener MouseListener) {
+	peer.GetMousePeer().RemoveMouseListener(listener)
+}
+
+// AddKeyListener registers a listener for notifying key event.
+func (sim *simra) AddKeyListener(listener KeyListener) {
+	peer.GetKeyPeer().AddKeyListener(listener)
+}
+
+// RemoveKeyListener unregisters a listener for notifying key event.
+func (sim *simra) RemoveKeyListener(listener KeyListener) {
+	peer.GetKeyPeer().RemoveKeyListener(listener)
+}
+
+// AddActionMap registers an ActionMap to current scene.
+// Registered ActionMap receives input events and
+// is updated every frame before Drive is called.
+// All ActionMaps are unregistered at changing of scene.
+func (sim *simra) AddActionMap(am *ActionMap) {
+	simlog.FuncIn()
+	sim.actionMaps = append(sim.actionMaps, am)
+	peer.GetTouchPeer().AddTouchListener(am)
+	peer.GetKeyPeer().AddKeyListener(am)
+	simlog.FuncOut()
+}
+
+// RemoveActionMap unregisters specified ActionMap.
+func (sim *simra) RemoveActionMap(am *ActionMap) {
+	simlog.FuncIn()
+	result := []*ActionMap{}
+	for _, v := range sim.actionMaps {
+		if v != am {
+			result = append(result, v)
+		}
+	}
+	sim.actionMaps = result
+	peer.GetTouchPeer().RemoveTouchListener(am)
+	peer.GetKeyPeer().RemoveKeyListener(am)
+	simlog.FuncOut()
+}
+
 // AddCollisionListener add a callback function that is called on
 // collision is detected between c1 and c2.
 func (sim *simra) AddCollisionListener(c1, c2 Collider, listener CollisionListener) {
@@ -237,15 +463,16 @@ func (sim *simra) RemoveAllCollisionListener() {
 	simlog.FuncOut()
 }
 
-// NewImageTexture allocates a texture from asset image
+// NewImageTexture allocates a texture from asset image.
+// Image of the same asset is decoded and uploaded once, and shared by the
+// textures until all of them are released.
 func (sim *simra) NewImageTexture(assetName string, rect image.Rectangle) *Texture {
 	simlog.FuncIn()
 
 	gl := sim.gl
-	tex := gl.LoadTexture(assetName, rect.Rectangle)
 	t := &Texture{
 		simra:   sim,
-		texture: gl.NewTexture(tex),
+		texture: gl.LoadTexture(assetName, rect.Rectangle),
 	}
 	runtime.SetFinalizer(t, (*Texture).release)
 
@@ -253,15 +480,67 @@ func (sim *simra) NewImageTexture(assetName string, rect image.Rectangle) *Textu
 	return t
 }
 
+// NewTextureFromImage returns a texture that has pixels of specified image.
+// This is useful for images generated at runtime, such as minimaps and QR codes.
+func (sim *simra) NewTextureFromImage(img goimage.Image) *Texture {
+	simlog.FuncIn()
+
+	t := &Texture{
+		simra:   sim,
+		texture: sim.gl.NewTextureFromImage(img),
+	}
+	runtime.SetFinalizer(t, (*Texture).release)
+
+	simlog.FuncOut()
+	return t
+}
+
+// NewTextureFromBytes returns a texture of image encoded in specified bytes,
+// such as an avatar downloaded from a server. Format is detected from the content.
+// png, jpeg and gif are supported.
+func (sim *simra) NewTextureFromBytes(b []byte) (*Texture, error) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	pt, err := sim.gl.NewTextureFromBytes(b)
+	if err != nil {
+		return nil, err
+	}
+	t := &Texture{
+		simra:   sim,
+		texture: pt,
+	}
+	runtime.SetFinalizer(t, (*Texture).release)
+	return t, nil
+}
+
+// NewNinePatchTexture loads Android's nine-patch image (.9.png) of specified asset.
+// Returned texture is the image without its border, and returned insets divide
+// the texture by stretchable area marked on the border.
+func (sim *simra) NewNinePatchTexture(assetName string) (*Texture, *NineSlice, error) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	pt, ns, err := sim.gl.LoadNinePatch(assetName)
+	if err != nil {
+		return nil, nil, err
+	}
+	t := &Texture{
+		simra:   sim,
+		texture: pt,
+	}
+	runtime.SetFinalizer(t, (*Texture).release)
+	return t, ns, nil
+}
+
 // NewTextTexture allocates a texture from specified text
 func (sim *simra) NewTextTexture(text string, fontsize float64, fontcolor color.RGBA, rect image.Rectangle) *Texture {
 	simlog.FuncIn()
 
 	gl := sim.gl
-	tex := gl.MakeTextureByText(text, fontsize, fontcolor, rect.Rectangle)
 	t := &Texture{
 		simra:   sim,
-		texture: gl.NewTexture(tex),
+		texture: gl.MakeTextureByText(text, fontsize, fontcolor, rect.Rectangle),
 	}
 	runtime.SetFinalizer(t, (*Texture).release)
 
@@ -273,9 +552,23 @@ type point struct {
 	x, y float32
 }
 
+// visibler is implemented by colliders that can be hidden, such as Spriter.
+type visibler interface {
+	IsVisible() bool
+}
+
+func isHidden(c Collider) bool {
+	v, ok := c.(visibler)
+	return ok && !v.IsVisible()
+}
+
 func (sim *simra) collisionCheckAndNotify() {
 	// check collision
 	for _, v := range sim.comap {
+		if isHidden(v.c1) || isHidden(v.c2) {
+			// hidden collider doesn't collide
+			continue
+		}
 		// TODO: refactoring around here...
 		x1, y1, w1, h1 := v.c1.GetXYWH()
 		x2, y2, w2, h2 := v.c2.GetXYWH()
@@ -310,3 +603,20 @@ func (sim *simra) comapLength() int {
 func (sim *simra) SetOnStopCallback(f func()) {
 	sim.onStop = f
 }
+
+// GetRenderStats returns the number of sprites drawn and culled in the last frame.
+func (sim *simra) GetRenderStats() RenderStats {
+	return sim.gl.GetRenderStats()
+}
+
+// GetTextureStats returns the number and memory usage of textures loaded by NewImageTexture.
+// Textures of the same asset are shared until all of them are released.
+func (sim *simra) GetTextureStats() TextureStats {
+	return sim.gl.GetTextureStats()
+}
+
+// GetLiveTextures returns textures held by GL with their sources, such as asset name.
+// Textures that are not released are listed. This is useful to find leaks.
+func (sim *simra) GetLiveTextures() []TextureInfo {
+	return sim.gl.GetLiveTextures()
+}
@@ -2,6 +2,8 @@ package simra
 
 import (
 	"context"
+	"fmt"
+	"image/color"
 
 	"github.com/pankona/gomo-simra/simra/fps"
 	"github.com/pankona/gomo-simra/simra/internal/peer"
@@ -14,9 +16,21 @@ type Spriter interface {
 	ReplaceTexture(texture *Texture)
 	// AddTouchListener registers a listener for touch event.
 	// Touch event will be notified when "sprite" is touched.
+	// Overlapped sprites are notified from front to back, and
+	// a listener can stop the propagation by implementing TouchConsumer.
+	// A sprite notified OnTouchBegin captures the touch, and receives
+	// OnTouchMove and OnTouchEnd even if the touch goes out of the sprite.
 	AddTouchListener(listener peer.TouchListener)
+	// RemoveTouchListener removes specified listener.
+	RemoveTouchListener(listener peer.TouchListener)
 	// RemoveAllTouchListener removes all listeners already registered.
 	RemoveAllTouchListener()
+	// AddPointerListener registers a listener for hover event.
+	// OnPointerEnter and OnPointerLeave will be notified when mouse cursor
+	// enters into or leaves from "sprite".
+	AddPointerListener(listener PointerListener)
+	// RemoveAllPointerListener removes all hover listeners already registered.
+	RemoveAllPointerListener()
 	// AddAnimationSet adds a specified AnimationSet to sprite
 	AddAnimationSet(animationName string, set *AnimationSet)
 	// StartAnimation starts animation by specified animation name
@@ -43,8 +57,165 @@ type Spriter interface {
 	SetRotate(r float32)
 	// getRotate gets sprite's rotation
 	GetRotate() float32
+	// SetHitShape sets a shape used for hit testing of touch and hover.
+	// Hit testing considers sprite's rotation.
+	// Specifying nil resets the shape to whole rectangle of sprite.
+	SetHitShape(shape HitShape)
+	// SetAlpha sets sprite's opacity. 0 is transparent and 1 is opaque.
+	SetAlpha(alpha float32)
+	// GetAlpha gets sprite's opacity
+	GetAlpha() float32
+	// SetTint sets color multiplied to sprite's texture.
+	// White means no tint. Alpha of tint is multiplied to sprite's alpha.
+	SetTint(tint color.RGBA)
+	// GetTint gets color multiplied to sprite's texture
+	GetTint() color.RGBA
+	// SetBlendMode sets how sprite is blended with the background.
+	SetBlendMode(mode BlendMode)
+	// GetBlendMode gets how sprite is blended with the background.
+	GetBlendMode() BlendMode
+	// SetFlip sets whether sprite's texture is mirrored horizontally and vertically.
+	// Texture is mirrored around the center of sprite.
+	SetFlip(x, y bool)
+	// GetFlip gets whether sprite's texture is mirrored horizontally and vertically.
+	GetFlip() (x, y bool)
+	// SetAnchor sets anchor point of sprite in 0..1 of sprite's size.
+	// (0, 0) is bottom left and (1, 1) is top right of sprite.
+	// Sprite's position specifies where the anchor point is placed,
+	// and sprite rotates and skews around the anchor point.
+	// Default is (0.5, 0.5), that is the center of sprite.
+	SetAnchor(x, y float32)
+	// GetAnchor gets anchor point of sprite
+	GetAnchor() (x, y float32)
+	// SetSkew sets skew angles of sprite in radian.
+	// x slants vertical edges and y slants horizontal edges.
+	SetSkew(x, y float32)
+	// GetSkew gets skew angles of sprite
+	GetSkew() (x, y float32)
+	// AddChild adds specified sprite as a child.
+	// Position, rotation, skew and scale factor of the child are relative to
+	// the anchor point of this sprite, and alpha and visibility of this sprite
+	// are inherited to the child. z-index of the child is kept independent.
+	// If the child already has another parent, it is detached from the parent
+	// keeping its relative values.
+	AddChild(child Spriter)
+	// RemoveChild detaches specified child from this sprite.
+	// Detached sprite's position becomes relative to virtual screen.
+	RemoveChild(child Spriter)
+	// GetParent gets parent of this sprite. nil if this sprite is a root.
+	GetParent() Spriter
+	// GetChildren gets children of this sprite.
+	GetChildren() []Spriter
+	// SetScaleFactor sets scale factor of sprite.
+	// The factor multiplies size of sprite and is inherited to its children.
+	// Default is (1, 1).
+	SetScaleFactor(x, y float32)
+	// GetScaleFactor gets scale factor of sprite
+	GetScaleFactor() (x, y float32)
+	// SetVisible sets sprite's visibility. Default is true.
+	// Hidden sprite and its descendants are skipped by rendering, touch dispatch
+	// and collision detection, but keep their texture, z-index and listeners.
+	SetVisible(visible bool)
+	// IsVisible returns true if sprite and all of its ancestors are visible
+	IsVisible() bool
+	// SetScreenSpace sets whether sprite is placed in screen space.
+	// Screen-space sprite is not affected by camera, that is useful for HUD.
+	// Children of screen-space sprite are also placed in screen space.
+	SetScreenSpace(screenSpace bool)
+	// IsScreenSpace returns true if sprite or its root ancestor is placed in screen space
+	IsScreenSpace() bool
+	// SetLayerBits sets layer bits of sprite.
+	// Sprite is rendered in viewports whose layer mask shares any bit with
+	// the layer bits. Default is 1.
+	SetLayerBits(bits uint32)
+	// GetLayerBits gets layer bits of sprite
+	GetLayerBits() uint32
+	// SetLayer sets the layer that sprite belongs to.
+	// nil means the default layer.
+	SetLayer(l Layer)
+	// GetLayer gets the layer that sprite belongs to
+	GetLayer() Layer
+	// SetClipRect clips sprite and its descendants to specified rectangle
+	// in virtual screen coordinates. (x, y) is bottom left of the rectangle.
+	// Parent sprite can be used as a container that clips its children,
+	// such as a scrollable list. Clipped area is not touched.
+	SetClipRect(x, y, w, h float32)
+	// ClearClipRect removes clip rectangle of sprite
+	ClearClipRect()
+	// GetClipRect gets clip rectangle of sprite. ok is false if not clipped.
+	GetClipRect() (x, y, w, h float32, ok bool)
+	// SetMask masks sprite and its descendants by alpha of mask sprite's texture.
+	// Only the area where mask's alpha is equal to or greater than threshold
+	// is drawn. Mask sprite must be added to scene to have texture, and it can
+	// be hidden not to be drawn itself. Specifying nil removes the mask.
+	SetMask(mask Spriter, threshold uint8)
+	// GetMask gets mask sprite and its threshold. nil if not masked.
+	GetMask() (Spriter, uint8)
+	// SetMaterial sets material that draws sprite.
+	// Specifying nil resets to the default drawing.
+	SetMaterial(m Material)
+	// GetMaterial gets material that draws sprite. nil if not set.
+	GetMaterial() Material
+	// SetNineSlice draws sprite's texture by nine pieces divided by specified insets,
+	// such as buttons and dialog frames. Corners are not scaled by sprite's size,
+	// edges are stretched along them and the center is stretched or tiled.
+	// Specifying nil draws whole texture.
+	SetNineSlice(ns *NineSlice)
+	// GetNineSlice gets insets of nine-slice drawing. nil if not nine-sliced.
+	GetNineSlice() *NineSlice
+	// SetTextureWrap repeats sprite's texture over sprite of any size, such as
+	// scrolling backgrounds. Scroll the texture by changing offset of w and
+	// setting it again. Nine-sliced sprite does not repeat its texture.
+	// Specifying nil stops repetition.
+	SetTextureWrap(w *TextureWrap)
+	// GetTextureWrap gets repetition of sprite's texture. nil if not repeated.
+	GetTextureWrap() *TextureWrap
+	// LocalToWorld converts specified position relative to the anchor point
+	// of this sprite to virtual screen coordinates.
+	LocalToWorld(x, y float32) (float32, float32)
+	// WorldToLocal converts specified position in virtual screen coordinates
+	// to the position relative to the anchor point of this sprite.
+	WorldToLocal(x, y float32) (float32, float32)
 }
 
+// BlendMode represents how a sprite is blended with the background.
+type BlendMode = peer.BlendMode
+
+const (
+	// BlendNormal is ordinary alpha blending. This is default.
+	BlendNormal = peer.BlendNormal
+	// BlendAdditive adds sprite's color to the background. useful for glows.
+	BlendAdditive = peer.BlendAdditive
+	// BlendMultiply multiplies sprite's color with the background. useful for shadows.
+	BlendMultiply = peer.BlendMultiply
+	// BlendScreen brightens the background by sprite's color.
+	BlendScreen = peer.BlendScreen
+)
+
+// NineSlice represents insets in pixels that divide a texture into nine pieces.
+// Corners are drawn in their size in pixels as units of sprite's size.
+type NineSlice = peer.NineSlice
+
+// TextureWrap represents repetition of texture over sprite.
+// Texture coordinates of sprite, that are (0, 0) at top left and (1, 1) at
+// bottom right, are multiplied by scale and shifted by offset.
+type TextureWrap = peer.TextureWrap
+
+// HitShape represents a shape of sprite for hit testing.
+// x and y are given in sprite-local coordinates, that is,
+// the origin is the center of sprite and the axes are rotated with sprite.
+// w and h are the size of sprite.
+type HitShape peer.HitShape
+
+// HitShapeFunc is an adapter to use a function as HitShape.
+type HitShapeFunc = peer.HitShapeFunc
+
+// RectShape is a HitShape that hits whole rectangle of sprite.
+type RectShape = peer.RectShape
+
+// EllipseShape is a HitShape of an ellipse inscribed in sprite's rectangle.
+type EllipseShape = peer.EllipseShape
+
 // Position represents position of sprite
 type Position struct {
 	X, Y float32
@@ -62,6 +233,8 @@ type sprite struct {
 	animationSets   map[string]*AnimationSet
 	animationCancel func()
 	texture         *Texture
+	mask            *sprite
+	material        *material
 }
 
 // ReplaceTexture replaces sprite's texture with specified image resource.
@@ -82,6 +255,13 @@ func (sprite *sprite) AddTouchListener(listener peer.TouchListener) {
 	simlog.FuncOut()
 }
 
+// RemoveTouchListener removes specified listener.
+func (sprite *sprite) RemoveTouchListener(listener peer.TouchListener) {
+	simlog.FuncIn()
+	sprite.Sprite.RemoveTouchListener(listener)
+	simlog.FuncOut()
+}
+
 // RemoveAllTouchListener removes all listeners already registered.
 func (sprite *sprite) RemoveAllTouchListener() {
 	simlog.FuncIn()
@@ -89,6 +269,22 @@ func (sprite *sprite) RemoveAllTouchListener() {
 	simlog.FuncOut()
 }
 
+// AddPointerListener registers a listener for hover event.
+// OnPointerEnter and OnPointerLeave will be notified when mouse cursor
+// enters into or leaves from "sprite".
+func (sprite *sprite) AddPointerListener(listener PointerListener) {
+	simlog.FuncIn()
+	sprite.Sprite.AddPointerListener(listener)
+	simlog.FuncOut()
+}
+
+// RemoveAllPointerListener removes all hover listeners already registered.
+func (sprite *sprite) RemoveAllPointerListener() {
+	simlog.FuncIn()
+	sprite.Sprite.RemoveAllPointerListener()
+	simlog.FuncOut()
+}
+
 // AddAnimationSet adds a specified AnimationSet to sprite
 func (sprite *sprite) AddAnimationSet(animationName string, set *AnimationSet) {
 	simlog.FuncIn()
@@ -189,3 +385,85 @@ func (sprite *sprite) SetRotate(r float32) {
 func (sprite *sprite) GetRotate() float32 {
 	return sprite.R
 }
+
+func (sprite *sprite) SetHitShape(shape HitShape) {
+	sprite.Sprite.SetHitShape(shape)
+}
+
+func (sprite *sprite) AddChild(child Spriter) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+	c := asSprite(child)
+	err := sprite.Sprite.AddChild(&c.Sprite)
+	if err != nil {
+		simlog.Errorf("failed to add child. err: %s", err.Error())
+	}
+}
+
+func (sprite *sprite) RemoveChild(child Spriter) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+	sprite.Sprite.RemoveChild(&asSprite(child).Sprite)
+}
+
+// spriteOf returns the sprite of s. ok is false if s is not created by simra.
+func spriteOf(s Spriter) (sp *sprite, ok bool) {
+	switch v := s.(type) {
+	case *sprite:
+		return v, true
+	case *shapeSprite:
+		return v.sprite, true
+	}
+	return nil, false
+}
+
+func asSprite(s Spriter) *sprite {
+	sp, ok := spriteOf(s)
+	if !ok {
+		panic(fmt.Sprintf("simra: %T is not a sprite of simra", s))
+	}
+	return sp
+}
+
+// wrapperOf returns Spriter that wraps specified sprite of peer.
+// nil if the sprite is not created by simra.
+func wrapperOf(s *peer.Sprite) Spriter {
+	if s == nil {
+		return nil
+	}
+	w, _ := s.GetOwner().(Spriter)
+	return w
+}
+
+func (sprite *sprite) GetParent() Spriter {
+	return wrapperOf(sprite.Sprite.GetParent())
+}
+
+func (sprite *sprite) GetChildren() []Spriter {
+	var children []Spriter
+	for _, c := range sprite.Sprite.GetChildren() {
+		if w := wrapperOf(c); w != nil {
+			children = append(children, w)
+		}
+	}
+	return children
+}
+
+func (sprite *sprite) SetMask(mask Spriter, threshold uint8) {
+	if mask == nil {
+		sprite.mask = nil
+		sprite.Sprite.SetMask(nil, threshold)
+		return
+	}
+	sprite.mask = asSprite(mask)
+	sprite.Sprite.SetMask(&sprite.mask.Sprite, threshold)
+}
+
+func (sprite *sprite) GetMask() (Spriter, uint8) {
+	_, threshold := sprite.Sprite.GetMask()
+	if sprite.mask == nil {
+		// avoid returning non-nil interface holding nil pointer
+		return nil, threshold
+	}
+	return sprite.mask, threshold
+}
@@ -0,0 +1,35 @@
+package simra
+
+import "testing"
+
+func TestSpriteHierarchy(t *testing.T) {
+	sim := &simra{}
+	parent := sim.NewSprite()
+	other := sim.NewSprite()
+	child := sim.NewSprite()
+	shape := sim.NewShape()
+
+	parent.AddChild(child)
+	parent.AddChild(shape)
+	if child.GetParent() != parent || shape.GetParent() != parent {
+		t.Errorf("unexpected parent")
+	}
+	children := parent.GetChildren()
+	if len(children) != 2 || children[0] != child || children[1] != shape {
+		t.Errorf("unexpected result. [got] %v [want] %v", children, []Spriter{child, shape})
+	}
+	if _, ok := children[1].(Shape); !ok {
+		t.Errorf("shape child should be returned as a shape")
+	}
+
+	// moving to another parent detaches the child from the former one
+	other.AddChild(child)
+	if child.GetParent() != other || len(parent.GetChildren()) != 1 || len(other.GetChildren()) != 1 {
+		t.Errorf("child should be moved to another parent")
+	}
+
+	other.RemoveChild(child)
+	if child.GetParent() != nil || len(other.GetChildren()) != 0 {
+		t.Errorf("child should be removed")
+	}
+}
@@ -0,0 +1,116 @@
+package simra
+
+import "github.com/pankona/gomo-simra/simra/simlog"
+
+// SpritePool recycles sprites that are frequently added and removed,
+// such as bullets of shooting games, to avoid creating sprites every time.
+// Sprites acquired from the pool are removed at changing of scene,
+// so that a pool should be created for each scene.
+type SpritePool interface {
+	// Acquire adds a sprite of the pool to current scene and returns it.
+	// A released sprite is reused if available, otherwise a new sprite is
+	// created and initialized by init function of the pool.
+	// Reused sprite keeps its texture, position and so on at releasing.
+	Acquire() Spriter
+	// Release removes specified sprite from current scene and makes it
+	// available to be acquired again. Animation of the sprite is stopped.
+	Release(s Spriter)
+	// Stats returns statistics of the pool
+	Stats() SpritePoolStats
+}
+
+// SpritePoolStats represents statistics of a sprite pool
+type SpritePoolStats struct {
+	// Created is the number of sprites created by the pool
+	Created int
+	// Active is the number of sprites acquired and not released yet
+	Active int
+	// Idle is the number of released sprites waiting to be acquired
+	Idle int
+	// Acquired is the total number of acquisitions
+	Acquired int
+	// Reused is the number of acquisitions that reused a released sprite
+	Reused int
+}
+
+type spritePool struct {
+	sim      *simra
+	init     func(s Spriter)
+	active   map[*sprite]struct{}
+	idle     []*sprite
+	created  int
+	acquired int
+	reused   int
+}
+
+// NewSpritePool returns a sprite pool. init is called for every sprite
+// created by the pool after it is added to current scene, to set its texture,
+// size, listeners and so on. init can be nil.
+func (sim *simra) NewSpritePool(init func(s Spriter)) SpritePool {
+	return &spritePool{
+		sim:    sim,
+		init:   init,
+		active: make(map[*sprite]struct{}),
+	}
+}
+
+// Acquire adds a sprite of the pool to current scene and returns it.
+func (p *spritePool) Acquire() Spriter {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	p.acquired++
+	if n := len(p.idle); n > 0 {
+		s := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.active[s] = struct{}{}
+		p.reused++
+		p.sim.AddSprite(s)
+		if s.texture != nil {
+			s.ReplaceTexture(s.texture)
+		}
+		return s
+	}
+
+	s := p.sim.NewSprite().(*sprite)
+	p.active[s] = struct{}{}
+	p.created++
+	p.sim.AddSprite(s)
+	if p.init != nil {
+		p.init(s)
+	}
+	return s
+}
+
+// Release removes specified sprite from current scene and makes it
+// available to be acquired again.
+func (p *spritePool) Release(s Spriter) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+
+	sp, ok := spriteOf(s)
+	if !ok {
+		simlog.Errorf("sprite is not acquired from the pool")
+		return
+	}
+	if _, ok := p.active[sp]; !ok {
+		simlog.Errorf("sprite is not acquired from the pool, or already released")
+		return
+	}
+	delete(p.active, sp)
+	sp.StopAnimation()
+	// texture is kept unlike RemoveSprite to be reused
+	p.sim.spritecontainer.RemoveSprite(&sp.Sprite)
+	p.idle = append(p.idle, sp)
+}
+
+// Stats returns statistics of the pool
+func (p *spritePool) Stats() SpritePoolStats {
+	return SpritePoolStats{
+		Created:  p.created,
+		Active:   len(p.active),
+		Idle:     len(p.idle),
+		Acquired: p.acquired,
+		Reused:   p.reused,
+	}
+}
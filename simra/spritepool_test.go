@@ -0,0 +1,65 @@
+package simra
+
+import (
+	"testing"
+
+	"github.com/pankona/gomo-simra/simra/internal/peer"
+	mobilesprite "golang.org/x/mobile/exp/sprite"
+)
+
+type mockPoolContainer struct {
+	peer.SpriteContainerer
+	added map[*peer.Sprite]bool
+}
+
+func (m *mockPoolContainer) AddSprite(s *peer.Sprite, subTex *mobilesprite.SubTex, arrangeCallback func()) error {
+	m.added[s] = true
+	return nil
+}
+
+func (m *mockPoolContainer) RemoveSprite(s *peer.Sprite) {
+	delete(m.added, s)
+}
+
+func (m *mockPoolContainer) ReplaceTexture(s *peer.Sprite, t *peer.Texture) {
+	// nop
+}
+
+func TestSpritePool(t *testing.T) {
+	sc := &mockPoolContainer{added: make(map[*peer.Sprite]bool)}
+	sim := &simra{spritecontainer: sc}
+	initialized := 0
+	tex := &Texture{}
+	pool := sim.NewSpritePool(func(s Spriter) {
+		initialized++
+		s.ReplaceTexture(tex)
+	})
+
+	s1 := pool.Acquire()
+	s2 := pool.Acquire()
+	if len(sc.added) != 2 || initialized != 2 {
+		t.Fatalf("acquired sprites should be added and initialized")
+	}
+	pool.Release(s1)
+	if sc.added[&s1.(*sprite).Sprite] {
+		t.Errorf("released sprite should be removed")
+	}
+
+	// released sprite is reused keeping its texture
+	s3 := pool.Acquire()
+	if s3 != s1 || initialized != 2 {
+		t.Errorf("released sprite should be reused")
+	}
+	if s3.(*sprite).texture != tex {
+		t.Errorf("texture of reused sprite should be kept")
+	}
+
+	// releasing twice is ignored
+	pool.Release(s2)
+	pool.Release(s2)
+
+	want := SpritePoolStats{Created: 2, Active: 1, Idle: 1, Acquired: 3, Reused: 1}
+	if got := pool.Stats(); got != want {
+		t.Errorf("unexpected result. [got] %+v [want] %+v", got, want)
+	}
+}
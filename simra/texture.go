@@ -1,6 +1,9 @@
 package simra
 
 import (
+	"image"
+	"runtime"
+
 	"github.com/pankona/gomo-simra/simra/internal/peer"
 	"github.com/pankona/gomo-simra/simra/simlog"
 )
@@ -11,9 +14,42 @@ type Texture struct {
 	texture *peer.Texture
 }
 
-func (t *Texture) release() {
+// Release releases the texture. Sprites must not show the texture after it is released.
+// Texture that is not released is released when it is garbage collected,
+// but it is recommended to release textures explicitly.
+func (t *Texture) Release() {
 	simlog.FuncIn()
+	runtime.SetFinalizer(t, nil)
 	gl := t.simra.gl
 	gl.ReleaseTexture(t.texture)
 	simlog.FuncOut()
 }
+
+// release is the finalizer of texture.
+// GL must not be called from the goroutine of finalizers, so the texture
+// is released at the next update.
+func (t *Texture) release() {
+	t.simra.gl.DiscardTexture(t.texture)
+}
+
+// AlphaMask returns a HitShape that hits only opaque pixels of the texture.
+// A pixel whose alpha is equal to or greater than threshold is regarded as opaque.
+// Set the returned HitShape to sprite by SetHitShape to ignore touches on
+// transparent area of the sprite.
+func (t *Texture) AlphaMask(threshold uint8) HitShape {
+	return t.texture.AlphaMask(threshold)
+}
+
+// Size returns size of the texture in pixels.
+// Trimmed frame of an atlas has its original size.
+func (t *Texture) Size() (w, h int) {
+	return t.texture.Size()
+}
+
+// UpdatePixels replaces pixels of the texture with src, placing top left of
+// src at (x, y) of the texture. Pixels out of the texture are ignored.
+// Textures that share pixels with the texture, such as textures of the same
+// asset, are also updated.
+func (t *Texture) UpdatePixels(x, y int, src image.Image) {
+	t.simra.gl.UpdateTexture(t.texture, x, y, src)
+}
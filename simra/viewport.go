@@ -0,0 +1,83 @@
+package simra
+
+import (
+	"github.com/pankona/gomo-simra/simra/internal/peer"
+	"github.com/pankona/gomo-simra/simra/simlog"
+)
+
+// Viewport represents a rectangle of screen where the world is rendered
+// through its own camera. Viewports are useful for split-screen and minimaps.
+type Viewport interface {
+	// SetRect sets rectangle of the viewport in virtual screen coordinates.
+	// (x, y) is bottom left of the rectangle.
+	SetRect(x, y, w, h float32)
+	// GetRect gets rectangle of the viewport in virtual screen coordinates.
+	GetRect() (x, y, w, h float32)
+	// GetCamera returns camera of the viewport.
+	GetCamera() Camera
+	// SetLayerMask sets mask of layer bits to render.
+	// Sprites whose layer bits share no bit with the mask are not rendered
+	// in the viewport, and not touched in the viewport.
+	// Default is all bits.
+	SetLayerMask(mask uint32)
+	// GetLayerMask gets mask of layer bits to render.
+	GetLayerMask() uint32
+}
+
+type viewport struct {
+	peer   *peer.Viewport
+	camera *camera
+}
+
+func (v *viewport) SetRect(x, y, w, h float32) {
+	v.peer.SetRect(x, y, w, h)
+}
+
+func (v *viewport) GetRect() (x, y, w, h float32) {
+	return v.peer.GetRect()
+}
+
+func (v *viewport) GetCamera() Camera {
+	return v.camera
+}
+
+func (v *viewport) SetLayerMask(mask uint32) {
+	v.peer.SetLayerMask(mask)
+}
+
+func (v *viewport) GetLayerMask() uint32 {
+	return v.peer.GetLayerMask()
+}
+
+// AddViewport adds a viewport that occupies specified rectangle of virtual screen.
+// (x, y) is bottom left of the rectangle.
+// While no viewport is added, whole screen is rendered through the camera
+// returned by GetCamera. Once a viewport is added, only added viewports are rendered.
+// Touch is routed to the front-most viewport that contains the touched position.
+func (sim *simra) AddViewport(x, y, w, h float32) Viewport {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+	pv := peer.NewViewport(x, y, w, h)
+	v := &viewport{
+		peer:   pv,
+		camera: newCamera(pv.GetCamera()),
+	}
+	sim.viewports = append(sim.viewports, v)
+	sim.gl.AddViewport(pv)
+	return v
+}
+
+// RemoveViewport removes specified viewport from screen.
+func (sim *simra) RemoveViewport(v Viewport) {
+	simlog.FuncIn()
+	defer simlog.FuncOut()
+	var viewports []*viewport
+	for _, vp := range sim.viewports {
+		if vp == v {
+			sim.gl.RemoveViewport(vp.peer)
+			continue
+		}
+		viewports = append(viewports, vp)
+	}
+	sim.viewports = viewports
+}
@@ -0,0 +1,65 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux,!android
+
+package app
+
+import (
+	"golang.org/x/mobile/event/mouse"
+	"golang.org/x/mobile/event/touch"
+)
+
+// x11Pointer translates X11 button and motion events into mouse events.
+// Touch events are sent as well while any button is held, so that apps
+// written for touch screens keep working with a mouse.
+type x11Pointer struct {
+	// pressed has the bits of held buttons, 1 << button.
+	pressed uint
+}
+
+// x11Buttons maps X11 button numbers to mouse buttons.
+// Buttons 4 to 7 are steps of vertical and horizontal wheels.
+var x11Buttons = map[int]mouse.Button{
+	1: mouse.ButtonLeft,
+	2: mouse.ButtonMiddle,
+	3: mouse.ButtonRight,
+	4: mouse.ButtonWheelUp,
+	5: mouse.ButtonWheelDown,
+	6: mouse.ButtonWheelLeft,
+	7: mouse.ButtonWheelRight,
+}
+
+// events returns the events for an X11 event at (x, y).
+// button is the X11 button number, or 0 for motion.
+func (p *x11Pointer) events(x, y float32, button int, dir mouse.Direction) []interface{} {
+	b := x11Buttons[button]
+	if b.IsWheel() {
+		// X11 reports a wheel step as a press and a release.
+		if dir != mouse.DirPress {
+			return nil
+		}
+		return []interface{}{mouse.Event{X: x, Y: y, Button: b, Direction: mouse.DirStep}}
+	}
+
+	events := []interface{}{mouse.Event{X: x, Y: y, Button: b, Direction: dir}}
+	held := p.pressed != 0
+	switch dir {
+	case mouse.DirPress:
+		p.pressed |= 1 << uint(button)
+		if !held {
+			events = append(events, touch.Event{X: x, Y: y, Type: touch.TypeBegin})
+		}
+	case mouse.DirRelease:
+		p.pressed &^= 1 << uint(button)
+		if held && p.pressed == 0 {
+			events = append(events, touch.Event{X: x, Y: y, Type: touch.TypeEnd})
+		}
+	case mouse.DirNone:
+		if held {
+			events = append(events, touch.Event{X: x, Y: y, Type: touch.TypeMove})
+		}
+	}
+	return events
+}
@@ -0,0 +1,62 @@
+// Copyright 2019 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux,!android
+
+package app
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/mobile/event/mouse"
+	"golang.org/x/mobile/event/touch"
+)
+
+func TestX11PointerEvents(t *testing.T) {
+	var p x11Pointer
+	steps := []struct {
+		button int
+		dir    mouse.Direction
+		want   []interface{}
+	}{
+		// hover without any button
+		{0, mouse.DirNone, []interface{}{
+			mouse.Event{X: 1, Y: 2},
+		}},
+		{3, mouse.DirPress, []interface{}{
+			mouse.Event{X: 1, Y: 2, Button: mouse.ButtonRight, Direction: mouse.DirPress},
+			touch.Event{X: 1, Y: 2, Type: touch.TypeBegin},
+		}},
+		// the second button doesn't begin another touch
+		{1, mouse.DirPress, []interface{}{
+			mouse.Event{X: 1, Y: 2, Button: mouse.ButtonLeft, Direction: mouse.DirPress},
+		}},
+		{0, mouse.DirNone, []interface{}{
+			mouse.Event{X: 1, Y: 2},
+			touch.Event{X: 1, Y: 2, Type: touch.TypeMove},
+		}},
+		{3, mouse.DirRelease, []interface{}{
+			mouse.Event{X: 1, Y: 2, Button: mouse.ButtonRight, Direction: mouse.DirRelease},
+		}},
+		{1, mouse.DirRelease, []interface{}{
+			mouse.Event{X: 1, Y: 2, Button: mouse.ButtonLeft, Direction: mouse.DirRelease},
+			touch.Event{X: 1, Y: 2, Type: touch.TypeEnd},
+		}},
+		// a wheel step is a press and a release of button 5
+		{5, mouse.DirPress, []interface{}{
+			mouse.Event{X: 1, Y: 2, Button: mouse.ButtonWheelDown, Direction: mouse.DirStep},
+		}},
+		{5, mouse.DirRelease, nil},
+		{6, mouse.DirPress, []interface{}{
+			mouse.Event{X: 1, Y: 2, Button: mouse.ButtonWheelLeft, Direction: mouse.DirStep},
+		}},
+	}
+	for i, s := range steps {
+		got := p.events(1, 2, s.button, s.dir)
+		if !reflect.DeepEqual(got, s.want) {
+			t.Errorf("step %d: got %v, want %v", i, got, s.want)
+		}
+	}
+}
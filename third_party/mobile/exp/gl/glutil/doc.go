@@ -0,0 +1,6 @@
+// Copyright 2014 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package glutil implements OpenGL utility functions.
+package glutil // import "golang.org/x/mobile/exp/gl/glutil"
@@ -0,0 +1,9 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include "textflag.h"
+
+// fixFloat is unnecessary for windows/386
+TEXT ·fixFloat(SB),NOSPLIT,$0-16
+	RET
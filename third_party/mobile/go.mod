@@ -0,0 +1,9 @@
+module golang.org/x/mobile
+
+go 1.11
+
+require (
+	golang.org/x/exp v0.0.0-20190306152737-a1d7652674e8
+	golang.org/x/image v0.0.0-20190227222117-0694c2d4d067
+	golang.org/x/sys v0.0.0-20190312061237-fead79001313 // indirect
+)